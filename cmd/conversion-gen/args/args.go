@@ -18,6 +18,7 @@ package args
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/pflag"
 
@@ -45,6 +46,12 @@ type Args struct {
 	// generator pick up manually written conversion funcs from external packages.
 	ExtraPeerDirs []string
 
+	// Peer dirs whose types and conversion funcs are read from compiled export data
+	// instead of being parsed from source. This is much faster for large dependencies
+	// and does not require their sources to be parseable, but comment tags in these
+	// packages are not visible to the generator.
+	ExportDataPeerDirs []string
+
 	// SkipUnsafe indicates whether to generate unsafe conversions to improve the efficiency
 	// of these operations. The unsafe operation is a direct pointer assignment via unsafe
 	// (within the allowed uses of unsafe) and is equivalent to a proposed Golang change to
@@ -60,6 +67,17 @@ type Args struct {
 	// groups of generators (external API that depends on Kube generations) should
 	// keep tags distinct as well.
 	GeneratedBuildTag string
+
+	// BenchmarkOutputFile is the name of a test file to be generated next to
+	// the conversions, holding one benchmark per generated conversion
+	// function. If empty, no benchmarks are generated.
+	BenchmarkOutputFile string
+
+	// FallbackReportFile is the path of a report listing every field which
+	// the generated conversion functions do not convert themselves and which
+	// therefore needs a hand-written conversion. "-" means stdout. If empty,
+	// no report is written.
+	FallbackReportFile string
 }
 
 // New returns default arguments for the generator.
@@ -79,11 +97,17 @@ func (args *Args) AddFlags(fs *pflag.FlagSet) {
 		"Comma-separated list of apimachinery import paths which are considered, after tag-specified peers, for conversions. Only change these if you have very good reasons.")
 	fs.StringSliceVar(&args.ExtraPeerDirs, "extra-peer-dirs", args.ExtraPeerDirs,
 		"Application specific comma-separated list of import paths which are considered, after tag-specified peers and base-peer-dirs, for conversions.")
+	fs.StringSliceVar(&args.ExportDataPeerDirs, "export-data-peer-dirs", args.ExportDataPeerDirs,
+		"Comma-separated list of import paths which are considered like extra-peer-dirs, but whose types are read from compiled export data instead of source. Comment tags in these packages are ignored.")
 	fs.BoolVar(&args.SkipUnsafe, "skip-unsafe", args.SkipUnsafe,
 		"If true, will not generate code using unsafe pointer conversions; resulting code may be slower.")
 	fs.StringVar(&args.GoHeaderFile, "go-header-file", "",
 		"the path to a file containing boilerplate header text; the string \"YEAR\" will be replaced with the current 4-digit year")
 	fs.StringVar(&args.GeneratedBuildTag, "build-tag", args.GeneratedBuildTag, "A Go build tag to use to identify files generated by this command. Should be unique.")
+	fs.StringVar(&args.BenchmarkOutputFile, "benchmark-output-file", args.BenchmarkOutputFile,
+		"the name of a _test.go file to be generated with a benchmark for each generated conversion function; if empty, no benchmarks are generated")
+	fs.StringVar(&args.FallbackReportFile, "fallback-report-file", args.FallbackReportFile,
+		"the path of a report listing fields which are not converted by generated code; \"-\" means stdout")
 }
 
 // Validate checks the given arguments.
@@ -91,5 +115,8 @@ func (args *Args) Validate() error {
 	if len(args.OutputFile) == 0 {
 		return fmt.Errorf("--output-file must be specified")
 	}
+	if len(args.BenchmarkOutputFile) > 0 && !strings.HasSuffix(args.BenchmarkOutputFile, "_test.go") {
+		return fmt.Errorf("--benchmark-output-file must end in _test.go")
+	}
 	return nil
 }
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"io"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+)
+
+const fuzzPackagePath = "github.com/google/gofuzz"
+
+// genConversionBenchmarks produces a test file with a benchmark for each
+// public conversion function emitted by a genConversion.
+type genConversionBenchmarks struct {
+	generator.GoGenerator
+	outputPackage string
+	conversions   *genConversion
+	imports       namer.ImportTracker
+}
+
+// NewGenConversionBenchmarks returns a generator which writes benchmarks for
+// the conversion functions generated by conversions. It must run after
+// conversions in the same target.
+func NewGenConversionBenchmarks(outputFilename, outputPackage string, conversions *genConversion) generator.Generator {
+	return &genConversionBenchmarks{
+		GoGenerator: generator.GoGenerator{
+			OutputFilename: outputFilename,
+		},
+		outputPackage: outputPackage,
+		conversions:   conversions,
+		imports:       generator.NewImportTrackerForPackage(outputPackage),
+	}
+}
+
+func (g *genConversionBenchmarks) Namers(c *generator.Context) namer.NameSystems {
+	return namer.NameSystems{
+		"raw": namer.NewRawNamer(g.outputPackage, g.imports),
+		"publicIT": &namerPlusImportTracking{
+			delegate: conversionNamer(),
+			tracker:  g.imports,
+		},
+	}
+}
+
+func (g *genConversionBenchmarks) Filter(c *generator.Context, t *types.Type) bool {
+	return false
+}
+
+func (g *genConversionBenchmarks) Imports(c *generator.Context) (imports []string) {
+	var importLines []string
+	for _, singleImport := range g.imports.ImportLines() {
+		if g.conversions.isOtherPackage(singleImport) {
+			importLines = append(importLines, singleImport)
+		}
+	}
+	return importLines
+}
+
+func (g *genConversionBenchmarks) Init(c *generator.Context, w io.Writer) error {
+	if len(g.conversions.generatedConversions) == 0 {
+		return nil
+	}
+	sw := generator.NewSnippetWriter(w, c, "$", "$")
+	scopeArgs := generator.Args{
+		"B":         types.Ref("testing", "B"),
+		"Meta":      types.Ref(conversionPackagePath, "Meta"),
+		"Scheme":    types.Ref(runtimePackagePath, "Scheme"),
+		"NewScheme": types.Ref(runtimePackagePath, "NewScheme"),
+	}
+	sw.Do(benchmarkScope, scopeArgs)
+	for _, pair := range g.conversions.generatedConversions {
+		args := argsFromType(pair.inType, pair.outType).
+			With("B", types.Ref("testing", "B")).
+			With("NewWithSeed", types.Ref(fuzzPackagePath, "NewWithSeed"))
+		sw.Do("func Benchmark"+nameTmpl+"(b *$.B|raw$) {\n", args)
+		sw.Do("s := newConversionBenchmarkScope(b)\n", nil)
+		sw.Do("in := new($.inType|raw$)\n", args)
+		sw.Do("$.NewWithSeed|raw$(1).NilChance(0).NumElements(1, 4).Fuzz(in)\n", args)
+		sw.Do("out := new($.outType|raw$)\n", args)
+		sw.Do("b.ReportAllocs()\n", nil)
+		sw.Do("b.ResetTimer()\n", nil)
+		sw.Do("for i := 0; i < b.N; i++ {\n", nil)
+		sw.Do("if err := "+nameTmpl+"(in, out, s); err != nil {\n", args)
+		sw.Do("b.Fatal(err)\n", nil)
+		sw.Do("}\n", nil)
+		sw.Do("}\n", nil)
+		sw.Do("}\n\n", nil)
+	}
+	return sw.Error()
+}
+
+// benchmarkScope is the conversion.Scope passed to the benchmarked functions,
+// which converts nested values through a scheme holding the conversions of the
+// package, like the scope of a runtime.Scheme does.
+const benchmarkScope = `
+// conversionBenchmarkScope is the conversion.Scope of the benchmarks, which
+// converts through a scheme holding the conversions of this package.
+type conversionBenchmarkScope struct {
+	scheme *$.Scheme|raw$
+	meta   *$.Meta|raw$
+}
+
+func newConversionBenchmarkScope(b *$.B|raw$) conversionBenchmarkScope {
+	scheme := $.NewScheme|raw$()
+	if err := RegisterConversions(scheme); err != nil {
+		b.Fatal(err)
+	}
+	return conversionBenchmarkScope{scheme: scheme, meta: &$.Meta|raw${}}
+}
+
+func (s conversionBenchmarkScope) Convert(src, dest interface{}) error {
+	return s.scheme.Convert(src, dest, s.meta.Context)
+}
+
+func (s conversionBenchmarkScope) Meta() *$.Meta|raw$ {
+	return s.meta
+}
+
+`
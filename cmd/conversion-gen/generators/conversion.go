@@ -195,7 +195,7 @@ func getManualConversionFunctions(context *generator.Context, pkg *types.Package
 	}
 }
 
-func GetTargets(context *generator.Context, args *args.Args) []generator.Target {
+func GetTargets(context *generator.Context, args *args.Args, report *FallbackReport) []generator.Target {
 	boilerplate, err := gengo.GoBoilerplate(args.GoHeaderFile, args.GeneratedBuildTag, gengo.StdGeneratedBy)
 	if err != nil {
 		klog.Fatalf("Failed loading boilerplate: %v", err)
@@ -282,6 +282,22 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 			klog.Fatalf("cannot load packages: %v", err)
 		}
 	}
+
+	// Peers read from export data are not parsed, so they must not be passed
+	// to LoadPackages.
+	if len(args.ExportDataPeerDirs) > 0 {
+		expanded, err := context.FindPackages(args.ExportDataPeerDirs...)
+		if err != nil {
+			klog.Fatalf("cannot find export data peer packages: %v", err)
+		}
+		if err := loadExportData(context.Universe, expanded); err != nil {
+			klog.Fatalf("cannot load export data peer packages: %v", err)
+		}
+		otherPkgs = append(otherPkgs, expanded...)
+		for k := range pkgToPeers {
+			pkgToPeers[k] = append(pkgToPeers[k], expanded...)
+		}
+	}
 	// update context.Order to the latest context.Universe
 	orderer := namer.Orderer{Namer: namer.NewPublicNamer(1)}
 	context.Order = orderer.OrderUniverse(context.Universe)
@@ -327,9 +343,14 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 					return t.Name.Package == typesPkg.Path
 				},
 				GeneratorsFunc: func(c *generator.Context) (generators []generator.Generator) {
-					return []generator.Generator{
-						NewGenConversion(args.OutputFile, typesPkg.Path, pkg.Path, manualConversions, pkgToPeers[pkg.Path], unsafeEquality),
+					conversions := NewGenConversion(args.OutputFile, typesPkg.Path, pkg.Path, manualConversions, pkgToPeers[pkg.Path], unsafeEquality, report)
+					generators = append(generators, conversions)
+					if len(args.BenchmarkOutputFile) > 0 {
+						// Benchmarks must come after the conversions, which
+						// decide which public functions are emitted.
+						generators = append(generators, NewGenConversionBenchmarks(args.BenchmarkOutputFile, pkg.Path, conversions.(*genConversion)))
 					}
+					return generators
 				},
 			})
 	}
@@ -430,6 +451,19 @@ func findMember(t *types.Type, name string) (types.Member, bool) {
 	return types.Member{}, false
 }
 
+// isNamedSliceOrMap returns true if t is a named type whose underlying type
+// is a slice or a map, e.g. "type Things []Thing".
+func isNamedSliceOrMap(t *types.Type) bool {
+	if t.Kind != types.Alias {
+		return false
+	}
+	switch unwrapAlias(t).Kind {
+	case types.Slice, types.Map:
+		return true
+	}
+	return false
+}
+
 // unwrapAlias recurses down aliased types to find the bedrock type.
 func unwrapAlias(in *types.Type) *types.Type {
 	for in.Kind == types.Alias {
@@ -467,9 +501,18 @@ type genConversion struct {
 	explicitConversions []conversionPair
 	skippedFields       map[*types.Type][]string
 	useUnsafe           TypesEqual
+	// generatedConversions holds the pairs for which a public Convert_
+	// function was emitted, in the order they were emitted.
+	generatedConversions []conversionPair
+	// report, if not nil, collects the fields which are not converted by
+	// generated code.
+	report *FallbackReport
+	// field is the struct field whose conversion is currently being
+	// generated, used to attribute entries in the report.
+	field fallbackField
 }
 
-func NewGenConversion(outputFilename, typesPackage, outputPackage string, manualConversions conversionFuncMap, peerPkgs []string, useUnsafe TypesEqual) generator.Generator {
+func NewGenConversion(outputFilename, typesPackage, outputPackage string, manualConversions conversionFuncMap, peerPkgs []string, useUnsafe TypesEqual, report *FallbackReport) generator.Generator {
 	return &genConversion{
 		GoGenerator: generator.GoGenerator{
 			OutputFilename: outputFilename,
@@ -483,6 +526,7 @@ func NewGenConversion(outputFilename, typesPackage, outputPackage string, manual
 		explicitConversions: []conversionPair{},
 		skippedFields:       map[*types.Type][]string{},
 		useUnsafe:           useUnsafe,
+		report:              report,
 	}
 }
 
@@ -529,7 +573,15 @@ func (g *genConversion) convertibleOnlyWithinPackage(inType, outType *types.Type
 		return false
 	}
 	// TODO: Consider generating functions for other kinds too.
-	if t.Kind != types.Struct {
+	switch {
+	case t.Kind == types.Struct:
+	case isNamedSliceOrMap(t):
+		// Named slice and map types are converted element-wise, so their
+		// peer must be of the same kind.
+		if unwrapAlias(other).Kind != unwrapAlias(t).Kind {
+			return false
+		}
+	default:
 		return false
 	}
 	// Also, filter out private types.
@@ -730,6 +782,7 @@ func (g *genConversion) generateConversion(inType, outType *types.Type, sw *gene
 		With("Scope", types.Ref(conversionPackagePath, "Scope"))
 
 	sw.Do("func auto"+nameTmpl+"(in *$.inType|raw$, out *$.outType|raw$, s $.Scope|raw$) error {\n", args)
+	g.field = fallbackField{inType: inType, outType: outType}
 	g.generateFor(inType, outType, sw)
 	sw.Do("return nil\n", nil)
 	sw.Do("}\n\n", nil)
@@ -749,6 +802,7 @@ func (g *genConversion) generateConversion(inType, outType *types.Type, sw *gene
 		sw.Do("func "+nameTmpl+"(in *$.inType|raw$, out *$.outType|raw$, s $.Scope|raw$) error {\n", args)
 		sw.Do("return auto"+nameTmpl+"(in, out, s)\n", args)
 		sw.Do("}\n\n", nil)
+		g.generatedConversions = append(g.generatedConversions, conversionPair{inType, outType})
 	}
 }
 
@@ -814,6 +868,7 @@ func (g *genConversion) doMap(inType, outType *types.Type, sw *generator.Snippet
 				args := argsFromType(inType.Elem, outType.Elem)
 				sw.Do("// FIXME: Provide conversion function to convert $.inType|raw$ to $.outType|raw$\n", args)
 				sw.Do("compileErrorOnMissingConversion()\n", nil)
+				g.recordFallback(fmt.Sprintf("missing conversion function for %v -> %v", inType.Elem, outType.Elem))
 				conversionExists = false
 			}
 			if conversionExists {
@@ -830,6 +885,7 @@ func (g *genConversion) doMap(inType, outType *types.Type, sw *generator.Snippet
 		// TODO: Implement it when necessary.
 		sw.Do("for range *in {\n", nil)
 		sw.Do("// FIXME: Converting unassignable keys unsupported $.|raw$\n", inType.Key)
+		g.recordFallback(fmt.Sprintf("converting unassignable map keys (%v) is unsupported", inType.Key))
 	}
 	sw.Do("}\n", nil)
 }
@@ -856,6 +912,7 @@ func (g *genConversion) doSlice(inType, outType *types.Type, sw *generator.Snipp
 				args := argsFromType(inType.Elem, outType.Elem)
 				sw.Do("// FIXME: Provide conversion function to convert $.inType|raw$ to $.outType|raw$\n", args)
 				sw.Do("compileErrorOnMissingConversion()\n", nil)
+				g.recordFallback(fmt.Sprintf("missing conversion function for %v -> %v", inType.Elem, outType.Elem))
 				conversionExists = false
 			}
 			if conversionExists {
@@ -868,7 +925,11 @@ func (g *genConversion) doSlice(inType, outType *types.Type, sw *generator.Snipp
 }
 
 func (g *genConversion) doStruct(inType, outType *types.Type, sw *generator.SnippetWriter) {
+	// Nested structs are attributed to their own fields, then to the field
+	// holding them again.
+	defer func(field fallbackField) { g.field = field }(g.field)
 	for _, inMember := range inType.Members {
+		g.field = fallbackField{inType: inType, outType: outType, name: inMember.Name}
 		if tagvals := extractTag(inMember.CommentLines); tagvals != nil && tagvals[0] == "false" {
 			// This field is excluded from conversion.
 			sw.Do("// INFO: in."+inMember.Name+" opted out of conversion generation\n", nil)
@@ -879,6 +940,7 @@ func (g *genConversion) doStruct(inType, outType *types.Type, sw *generator.Snip
 			// This field doesn't exist in the peer.
 			sw.Do("// WARNING: in."+inMember.Name+" requires manual conversion: does not exist in peer-type\n", nil)
 			g.skippedFields[inType] = append(g.skippedFields[inType], inMember.Name)
+			g.recordFallback("does not exist in peer-type")
 			continue
 		}
 
@@ -941,6 +1003,7 @@ func (g *genConversion) doStruct(inType, outType *types.Type, sw *generator.Snip
 			sw.Do("// WARNING: in."+inMember.Name+" requires manual conversion: inconvertible types ("+
 				inMemberType.String()+" vs "+outMemberType.String()+")\n", nil)
 			g.skippedFields[inType] = append(g.skippedFields[inType], inMember.Name)
+			g.recordFallback(fmt.Sprintf("inconvertible types (%v vs %v)", inMemberType, outMemberType))
 			continue
 		}
 
@@ -975,6 +1038,7 @@ func (g *genConversion) doStruct(inType, outType *types.Type, sw *generator.Snip
 				args := argsFromType(inMemberType, outMemberType)
 				sw.Do("// FIXME: Provide conversion function to convert $.inType|raw$ to $.outType|raw$\n", args)
 				sw.Do("compileErrorOnMissingConversion()\n", nil)
+				g.recordFallback(fmt.Sprintf("missing conversion function for %v -> %v", inMemberType, outMemberType))
 				conversionExists = false
 			}
 			if conversionExists {
@@ -996,6 +1060,7 @@ func (g *genConversion) doStruct(inType, outType *types.Type, sw *generator.Snip
 					args := argsFromType(inMemberType, outMemberType)
 					sw.Do("// FIXME: Provide conversion function to convert $.inType|raw$ to $.outType|raw$\n", args)
 					sw.Do("compileErrorOnMissingConversion()\n", nil)
+					g.recordFallback(fmt.Sprintf("missing conversion function for %v -> %v", inMemberType, outMemberType))
 					conversionExists = false
 				}
 				if conversionExists {
@@ -1011,6 +1076,7 @@ func (g *genConversion) doStruct(inType, outType *types.Type, sw *generator.Snip
 				args := argsFromType(inMemberType, outMemberType)
 				sw.Do("// FIXME: Provide conversion function to convert $.inType|raw$ to $.outType|raw$\n", args)
 				sw.Do("compileErrorOnMissingConversion()\n", nil)
+				g.recordFallback(fmt.Sprintf("missing conversion function for %v -> %v", inMemberType, outMemberType))
 				conversionExists = false
 			}
 			if conversionExists {
@@ -1054,6 +1120,7 @@ func (g *genConversion) doPointer(inType, outType *types.Type, sw *generator.Sni
 			args := argsFromType(inType.Elem, outType.Elem)
 			sw.Do("// FIXME: Provide conversion function to convert $.inType|raw$ to $.outType|raw$\n", args)
 			sw.Do("compileErrorOnMissingConversion()\n", nil)
+			g.recordFallback(fmt.Sprintf("missing conversion function for %v -> %v", inType.Elem, outType.Elem))
 			conversionExists = false
 		}
 		if conversionExists {
@@ -1064,12 +1131,25 @@ func (g *genConversion) doPointer(inType, outType *types.Type, sw *generator.Sni
 }
 
 func (g *genConversion) doAlias(inType, outType *types.Type, sw *generator.SnippetWriter) {
-	// TODO: Add support for aliases.
-	g.doUnknown(inType, outType, sw)
+	if !isNamedSliceOrMap(inType) || !isNamedSliceOrMap(outType) {
+		// TODO: Add support for other aliases.
+		g.doUnknown(inType, outType, sw)
+		return
+	}
+	// Named slices and maps are converted through their element converters,
+	// keeping the named types so that the results are assignable.
+	inUnderlying, outUnderlying := *unwrapAlias(inType), *unwrapAlias(outType)
+	inUnderlying.Name, outUnderlying.Name = inType.Name, outType.Name
+	sw.Do("if *in != nil {\n", nil)
+	g.generateFor(&inUnderlying, &outUnderlying, sw)
+	sw.Do("} else {\n", nil)
+	sw.Do("*out = nil\n", nil)
+	sw.Do("}\n", nil)
 }
 
 func (g *genConversion) doUnknown(inType, outType *types.Type, sw *generator.SnippetWriter) {
 	sw.Do("// FIXME: Type $.|raw$ is unsupported.\n", inType)
+	g.recordFallback(fmt.Sprintf("type %v is unsupported", inType))
 }
 
 func (g *genConversion) generateFromURLValues(inType, outType *types.Type, sw *generator.SnippetWriter) {
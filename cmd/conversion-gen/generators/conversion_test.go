@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestIsNamedSliceOrMap(t *testing.T) {
+	part := &types.Type{Name: types.Name{Package: "example.com/v1", Name: "Part"}, Kind: types.Struct}
+	parts := &types.Type{Name: types.Name{Name: "[]example.com/v1.Part"}, Kind: types.Slice, Elem: part}
+	partsByName := &types.Type{Name: types.Name{Name: "map[string]example.com/v1.Part"}, Kind: types.Map, Key: types.String, Elem: part}
+	named := func(name string, underlying *types.Type) *types.Type {
+		return &types.Type{Name: types.Name{Package: "example.com/v1", Name: name}, Kind: types.Alias, Underlying: underlying}
+	}
+
+	testcases := []struct {
+		Name   string
+		Type   *types.Type
+		Expect bool
+	}{
+		{Name: "named slice", Type: named("Parts", parts), Expect: true},
+		{Name: "named map", Type: named("PartsByName", partsByName), Expect: true},
+		{Name: "named named slice", Type: named("MoreParts", named("Parts", parts)), Expect: true},
+		{Name: "named string", Type: named("Phase", types.String)},
+		{Name: "unnamed slice", Type: parts},
+		{Name: "struct", Type: part},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := isNamedSliceOrMap(tc.Type); got != tc.Expect {
+				t.Errorf("expected %v, got %v", tc.Expect, got)
+			}
+		})
+	}
+}
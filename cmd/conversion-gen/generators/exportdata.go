@@ -0,0 +1,372 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	gotypes "go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// exportDataPackage describes a package as reported by `go list -export`.
+type exportDataPackage struct {
+	path    string
+	name    string
+	dir     string
+	export  string
+	goFiles []string
+}
+
+// listExportData runs `go list -export` for the given packages, which
+// compiles them (or reuses the build cache) and reports where their export
+// data lives.
+func listExportData(pkgPaths []string) ([]exportDataPackage, error) {
+	args := append([]string{"list", "-export", "-f", "{{.ImportPath}}\t{{.Name}}\t{{.Dir}}\t{{.Export}}\t{{join .GoFiles \",\"}}"}, pkgPaths...)
+	cmd := exec.Command("go", args...)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list -export failed: %w: %s", err, stderr.String())
+	}
+	return parseExportDataList(string(out))
+}
+
+// parseExportDataList parses the output of listExportData, one tab-separated
+// line per package.
+func parseExportDataList(out string) ([]exportDataPackage, error) {
+	var result []exportDataPackage
+	// Lines are not trimmed, since the last field may be empty.
+	for _, line := range strings.Split(out, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		parts := strings.Split(line, "\t")
+		if len(parts) != 5 || parts[0] == "" || parts[3] == "" {
+			return nil, fmt.Errorf("no export data for package: %q", line)
+		}
+		p := exportDataPackage{path: parts[0], name: parts[1], dir: parts[2], export: parts[3]}
+		if parts[4] != "" {
+			p.goFiles = strings.Split(parts[4], ",")
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// loadExportData adds the types and functions of the given packages to the
+// universe, reading them from compiled export data rather than type-checking
+// their sources. Export data carries no comments, so comment tags (for example
+// "+k8s:conversion-fn=copy-only") are recovered on a best-effort basis by
+// parsing, without type-checking, whichever source files are available.
+func loadExportData(u types.Universe, pkgPaths []string) error {
+	listed, err := listExportData(pkgPaths)
+	if err != nil {
+		return err
+	}
+	return importExportData(u, listed)
+}
+
+// importExportData adds the types and functions of the listed packages to
+// the universe, reading them from their export data files.
+func importExportData(u types.Universe, listed []exportDataPackage) error {
+	exports := map[string]string{}
+	for _, p := range listed {
+		exports[p.path] = p.export
+	}
+	imp := importer.ForCompiler(token.NewFileSet(), "gc", func(path string) (io.ReadCloser, error) {
+		export, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for package %q", path)
+		}
+		return os.Open(export)
+	})
+
+	w := exportDataWalker{universe: u}
+	for _, p := range listed {
+		klog.V(3).Infof("loading %q from export data %q", p.path, p.export)
+		goPkg, err := imp.Import(p.path)
+		if err != nil {
+			return fmt.Errorf("cannot import %q from export data: %w", p.path, err)
+		}
+		pkg := u.Package(p.path)
+		pkg.Path = p.path
+		pkg.Name = p.name
+		if pkg.Dir == "" {
+			pkg.Dir = p.dir
+		}
+		scope := goPkg.Scope()
+		for _, n := range scope.Names() {
+			switch obj := scope.Lookup(n).(type) {
+			case *gotypes.TypeName:
+				w.walkType(nil, obj.Type())
+			case *gotypes.Func:
+				// Only functions are interesting for conversions, not methods.
+				if sig := obj.Type().(*gotypes.Signature); sig.Recv() == nil {
+					f := u.Function(types.Name{Package: p.path, Name: obj.Name()})
+					f.Kind = types.DeclarationOf
+					f.Underlying = w.walkType(nil, sig)
+				}
+			}
+		}
+		attachComments(pkg, p)
+	}
+	return nil
+}
+
+// attachComments parses the sources of p, if any, for the doc comments of
+// top-level functions, types and struct fields and attaches them to pkg.
+// Files which fail to parse are skipped.
+func attachComments(pkg *types.Package, p exportDataPackage) {
+	fset := token.NewFileSet()
+	for _, file := range p.goFiles {
+		f, err := parser.ParseFile(fset, filepath.Join(p.dir, file), nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			klog.V(3).Infof("skipping comments in %s: %v", file, err)
+			continue
+		}
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if fn, ok := pkg.Functions[d.Name.Name]; ok && d.Recv == nil {
+					fn.CommentLines = commentLines(d.Doc)
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					ts, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+					t, ok := pkg.Types[ts.Name.Name]
+					if !ok {
+						continue
+					}
+					doc := ts.Doc
+					if doc == nil && len(d.Specs) == 1 {
+						doc = d.Doc
+					}
+					t.CommentLines = commentLines(doc)
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok || t.Kind != types.Struct {
+						continue
+					}
+					for _, field := range st.Fields.List {
+						for i := range t.Members {
+							if fieldHasName(field, t.Members[i].Name) {
+								t.Members[i].CommentLines = commentLines(field.Doc)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func commentLines(c *ast.CommentGroup) []string {
+	return strings.Split(strings.TrimRight(c.Text(), "\n"), "\n")
+}
+
+func fieldHasName(field *ast.Field, name string) bool {
+	for _, n := range field.Names {
+		if n.Name == name {
+			return true
+		}
+	}
+	if len(field.Names) == 0 {
+		// Embedded fields are named after their type.
+		expr := field.Type
+		if star, ok := expr.(*ast.StarExpr); ok {
+			expr = star.X
+		}
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return e.Name == name
+		case *ast.SelectorExpr:
+			return e.Sel.Name == name
+		}
+	}
+	return false
+}
+
+// exportDataWalker translates go/types types into the universe, producing the
+// same names and shapes as the gengo parser does for parsed packages, so that
+// types from both sources can be mixed freely.
+type exportDataWalker struct {
+	universe types.Universe
+}
+
+// nameOf converts a go/types type string to a gengo name.
+func nameOf(in gotypes.Type) types.Name {
+	s := in.String()
+	for _, prefix := range []string{"struct{", "<-chan", "chan<-", "chan ", "func(", "func (", "*", "map[", "["} {
+		if strings.HasPrefix(s, prefix) {
+			return types.Name{Name: s}
+		}
+	}
+	generic := strings.IndexRune(s, '[')
+	if generic == -1 {
+		generic = len(s)
+	}
+	dot := strings.LastIndex(s[:generic], ".")
+	if dot == -1 {
+		return types.Name{Name: s}
+	}
+	return types.Name{Package: s[:dot], Name: s[dot+1:]}
+}
+
+func (w exportDataWalker) signature(in *gotypes.Signature) *types.Signature {
+	out := &types.Signature{Variadic: in.Variadic()}
+	for i := 0; i < in.Params().Len(); i++ {
+		p := in.Params().At(i)
+		out.Parameters = append(out.Parameters, &types.ParamResult{Name: p.Name(), Type: w.walkType(nil, p.Type())})
+	}
+	for i := 0; i < in.Results().Len(); i++ {
+		r := in.Results().At(i)
+		out.Results = append(out.Results, &types.ParamResult{Name: r.Name(), Type: w.walkType(nil, r.Type())})
+	}
+	if r := in.Recv(); r != nil {
+		out.Receiver = w.walkType(nil, r.Type())
+	}
+	return out
+}
+
+func (w exportDataWalker) methods(out *types.Type, in *gotypes.Named) {
+	if len(out.Methods) != 0 {
+		return
+	}
+	for i := 0; i < in.NumMethods(); i++ {
+		if out.Methods == nil {
+			out.Methods = map[string]*types.Type{}
+		}
+		m := in.Method(i)
+		name := types.Name{Name: m.String()}
+		out.Methods[m.Name()] = w.walkType(&name, m.Type())
+	}
+}
+
+func (w exportDataWalker) walkType(useName *types.Name, in gotypes.Type) *types.Type {
+	in = gotypes.Unalias(in)
+	name := nameOf(in)
+	if useName != nil {
+		name = *useName
+	}
+	u := w.universe
+
+	switch t := in.(type) {
+	case *gotypes.Basic:
+		out := u.Type(types.Name{Name: t.Name()})
+		if out.Kind == types.Unknown {
+			out.Kind = types.Unsupported
+		}
+		return out
+	case *gotypes.TypeParam:
+		return &types.Type{Name: name, Kind: types.TypeParam}
+	case *gotypes.Named:
+		switch t.Underlying().(type) {
+		case *gotypes.Named, *gotypes.Basic, *gotypes.Map, *gotypes.Slice:
+			out := u.Type(name)
+			if out.Kind != types.Unknown {
+				return out
+			}
+			out.Kind = types.Alias
+			out.Underlying = w.walkType(nil, t.Underlying())
+			w.methods(out, t)
+			return out
+		default:
+			if tps := t.TypeParams(); tps.Len() != 0 {
+				// Name generic types the way the parser does, e.g.
+				// Foo[T any] => Foo[T].
+				var tpNames []string
+				for i := 0; i < tps.Len(); i++ {
+					tpNames = append(tpNames, tps.At(i).Obj().Name())
+				}
+				name.Name = fmt.Sprintf("%s[%s]", strings.SplitN(name.Name, "[", 2)[0], strings.Join(tpNames, ","))
+			}
+			if out := u.Type(name); out.Kind != types.Unknown {
+				return out
+			}
+			out := w.walkType(&name, t.Underlying())
+			w.methods(out, t)
+			return out
+		}
+	}
+
+	out := u.Type(name)
+	if out.Kind != types.Unknown {
+		return out
+	}
+	switch t := in.(type) {
+	case *gotypes.Struct:
+		out.Kind = types.Struct
+		for i := 0; i < t.NumFields(); i++ {
+			f := t.Field(i)
+			out.Members = append(out.Members, types.Member{
+				Name:     f.Name(),
+				Embedded: f.Anonymous(),
+				Tags:     t.Tag(i),
+				Type:     w.walkType(nil, f.Type()),
+			})
+		}
+	case *gotypes.Map:
+		out.Kind = types.Map
+		out.Elem = w.walkType(nil, t.Elem())
+		out.Key = w.walkType(nil, t.Key())
+	case *gotypes.Pointer:
+		out.Kind = types.Pointer
+		out.Elem = w.walkType(nil, t.Elem())
+	case *gotypes.Slice:
+		out.Kind = types.Slice
+		out.Elem = w.walkType(nil, t.Elem())
+	case *gotypes.Array:
+		out.Kind = types.Array
+		out.Elem = w.walkType(nil, t.Elem())
+		out.Len = t.Len()
+	case *gotypes.Chan:
+		out.Kind = types.Chan
+		out.Elem = w.walkType(nil, t.Elem())
+	case *gotypes.Signature:
+		out.Kind = types.Func
+		out.Signature = w.signature(t)
+	case *gotypes.Interface:
+		out.Kind = types.Interface
+		for i := 0; i < t.NumMethods(); i++ {
+			if out.Methods == nil {
+				out.Methods = map[string]*types.Type{}
+			}
+			m := t.Method(i)
+			mname := types.Name{Name: m.String()}
+			out.Methods[m.Name()] = w.walkType(&mname, m.Type())
+		}
+	default:
+		out.Kind = types.Unsupported
+		klog.Warningf("Making unsupported type entry %q for: %#v", out, t)
+	}
+	return out
+}
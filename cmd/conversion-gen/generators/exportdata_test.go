@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	gotypes "go/types"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestParseExportDataList(t *testing.T) {
+	testcases := []struct {
+		Name      string
+		Output    string
+		Expect    []exportDataPackage
+		ExpectErr bool
+	}{
+		{
+			Name:   "empty",
+			Output: "\n",
+		},
+		{
+			Name:   "packages",
+			Output: "example.com/v1\tv1\t/src/v1\t/cache/v1-d\ta.go,b.go\nexample.com/v2\tv2\t/src/v2\t/cache/v2-d\t\n",
+			Expect: []exportDataPackage{
+				{path: "example.com/v1", name: "v1", dir: "/src/v1", export: "/cache/v1-d", goFiles: []string{"a.go", "b.go"}},
+				{path: "example.com/v2", name: "v2", dir: "/src/v2", export: "/cache/v2-d"},
+			},
+		},
+		{
+			Name:      "no export data",
+			Output:    "example.com/v1\tv1\t/src/v1\t\ta.go\n",
+			ExpectErr: true,
+		},
+		{
+			Name:      "no import path",
+			Output:    "\tv1\t/src/v1\t/cache/v1-d\ta.go\n",
+			ExpectErr: true,
+		},
+		{
+			Name:      "truncated line",
+			Output:    "example.com/v1\tv1\t/src/v1\n",
+			ExpectErr: true,
+		},
+		{
+			Name:      "extra fields",
+			Output:    "example.com/v1\tv1\t/src/v1\t/cache/v1-d\ta.go\tb.go\n",
+			ExpectErr: true,
+		},
+		{
+			Name:      "malformed",
+			Output:    "go: warning: ignoring go.mod\n",
+			ExpectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			listed, err := parseExportDataList(tc.Output)
+			if tc.ExpectErr {
+				if err == nil {
+					t.Errorf("expected an error, got %v", listed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(listed, tc.Expect) {
+				t.Errorf("expected %#v, got %#v", tc.Expect, listed)
+			}
+		})
+	}
+}
+
+func TestImportExportData(t *testing.T) {
+	const pkgPath = "k8s.io/code-generator/cmd/conversion-gen/output_tests/widgets"
+	listed, err := listExportData([]string{pkgPath})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 1 {
+		t.Fatalf("expected one package, got %v", listed)
+	}
+	data, err := os.ReadFile(listed[0].export)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Cut the export data, which follows its "$$B" marker, in the middle.
+	exportStart := bytes.Index(data, []byte("$$B\n"))
+	if exportStart == -1 {
+		t.Fatalf("no export data in %s", listed[0].export)
+	}
+
+	dir := t.TempDir()
+	withExport := func(name string, data []byte) exportDataPackage {
+		p := listed[0]
+		p.export = filepath.Join(dir, name)
+		if data != nil {
+			if err := os.WriteFile(p.export, data, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return p
+	}
+
+	testcases := []struct {
+		Name      string
+		Package   exportDataPackage
+		ExpectErr bool
+	}{
+		{
+			Name:    "valid",
+			Package: withExport("valid", data),
+		},
+		{
+			Name:      "missing",
+			Package:   withExport("missing", nil),
+			ExpectErr: true,
+		},
+		{
+			Name:      "empty",
+			Package:   withExport("empty", []byte{}),
+			ExpectErr: true,
+		},
+		{
+			Name:      "truncated header",
+			Package:   withExport("truncated-header", data[:8]),
+			ExpectErr: true,
+		},
+		{
+			Name:      "truncated",
+			Package:   withExport("truncated", data[:exportStart+64]),
+			ExpectErr: true,
+		},
+		{
+			Name:      "malformed",
+			Package:   withExport("malformed", []byte("!<arch>\nthis is not export data\n")),
+			ExpectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			u := types.Universe{}
+			err := importExportData(u, []exportDataPackage{tc.Package})
+			if tc.ExpectErr {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			widget := u.Type(types.Name{Package: pkgPath, Name: "Widget"})
+			if widget.Kind != types.Struct {
+				t.Fatalf("expected Widget to be a struct, got %v", widget.Kind)
+			}
+			members := map[string]types.Member{}
+			for _, m := range widget.Members {
+				members[m.Name] = m
+			}
+			for _, name := range []string{"Name", "Spec", "Legacy"} {
+				if _, ok := members[name]; !ok {
+					t.Errorf("expected Widget to have a member %s, got %v", name, widget.Members)
+				}
+			}
+			if comments := members["Legacy"].CommentLines; !reflect.DeepEqual(comments, []string{"Legacy does not exist in v1."}) {
+				t.Errorf("expected the comments of Legacy to be attached, got %q", comments)
+			}
+		})
+	}
+}
+
+func TestNameOf(t *testing.T) {
+	pkg := gotypes.NewPackage("example.com/v1", "v1")
+	named := gotypes.NewNamed(gotypes.NewTypeName(0, pkg, "Widget", nil), gotypes.NewStruct(nil, nil), nil)
+
+	testcases := []struct {
+		Name   string
+		Type   gotypes.Type
+		Expect types.Name
+	}{
+		{
+			Name:   "builtin",
+			Type:   gotypes.Typ[gotypes.Int32],
+			Expect: types.Name{Name: "int32"},
+		},
+		{
+			Name:   "named",
+			Type:   named,
+			Expect: types.Name{Package: "example.com/v1", Name: "Widget"},
+		},
+		{
+			Name:   "pointer",
+			Type:   gotypes.NewPointer(named),
+			Expect: types.Name{Name: "*example.com/v1.Widget"},
+		},
+		{
+			Name:   "slice",
+			Type:   gotypes.NewSlice(named),
+			Expect: types.Name{Name: "[]example.com/v1.Widget"},
+		},
+		{
+			Name:   "map",
+			Type:   gotypes.NewMap(gotypes.Typ[gotypes.String], named),
+			Expect: types.Name{Name: "map[string]example.com/v1.Widget"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if name := nameOf(tc.Type); name != tc.Expect {
+				t.Errorf("expected %#v, got %#v", tc.Expect, name)
+			}
+		})
+	}
+}
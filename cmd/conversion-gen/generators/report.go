@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/gengo/v2/types"
+)
+
+// fallbackField identifies a field of a struct being converted, or a whole
+// type being converted if name is empty.
+type fallbackField struct {
+	inType  *types.Type
+	outType *types.Type
+	name    string
+}
+
+// FallbackReport collects the fields which generated conversion functions do
+// not convert themselves. Such fields need a hand-written conversion function
+// (or leave the pair without a generated public Convert_ function), so an
+// empty report means that every conversion is fully code-generated.
+type FallbackReport struct {
+	entries []string
+}
+
+// NewFallbackReport returns an empty report.
+func NewFallbackReport() *FallbackReport {
+	return &FallbackReport{}
+}
+
+func (r *FallbackReport) add(field fallbackField, reason string) {
+	if len(field.name) == 0 {
+		r.entries = append(r.entries, fmt.Sprintf("%v -> %v: %s", field.inType, field.outType, reason))
+		return
+	}
+	r.entries = append(r.entries, fmt.Sprintf("%v -> %v, %s: %s", field.inType, field.outType, field.name, reason))
+}
+
+// WriteTo writes the sorted, de-duplicated entries of the report to w, one
+// per line.
+func (r *FallbackReport) WriteTo(w io.Writer) (int64, error) {
+	entries := append([]string(nil), r.entries...)
+	sort.Strings(entries)
+	var b strings.Builder
+	for i, e := range entries {
+		if i > 0 && entries[i-1] == e {
+			continue
+		}
+		b.WriteString(e)
+		b.WriteString("\n")
+	}
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// recordFallback adds the field currently being generated to the report.
+func (g *genConversion) recordFallback(reason string) {
+	if g.report == nil {
+		return
+	}
+	g.report.add(g.field, reason)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+func TestFallbackReportWriteTo(t *testing.T) {
+	in := &types.Type{Name: types.Name{Package: "example.com/internal", Name: "Widget"}}
+	out := &types.Type{Name: types.Name{Package: "example.com/v1", Name: "Widget"}}
+
+	r := NewFallbackReport()
+	r.add(fallbackField{inType: in, outType: out, name: "Size"}, "inconvertible types (int vs string)")
+	r.add(fallbackField{inType: in, outType: out, name: "Legacy"}, "does not exist in peer-type")
+	r.add(fallbackField{inType: in, outType: out, name: "Size"}, "inconvertible types (int vs string)")
+	r.add(fallbackField{inType: in, outType: out}, "type example.com/internal.Widget is unsupported")
+
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Join([]string{
+		"example.com/internal.Widget -> example.com/v1.Widget, Legacy: does not exist in peer-type",
+		"example.com/internal.Widget -> example.com/v1.Widget, Size: inconvertible types (int vs string)",
+		"example.com/internal.Widget -> example.com/v1.Widget: type example.com/internal.Widget is unsupported",
+		"",
+	}, "\n")
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestRecordFallbackAttribution(t *testing.T) {
+	inStruct := &types.Type{
+		Name:    types.Name{Package: "example.com/internal", Name: "Widget"},
+		Kind:    types.Struct,
+		Members: []types.Member{{Name: "Legacy", Type: types.String}},
+	}
+	outStruct := &types.Type{
+		Name: types.Name{Package: "example.com/v1", Name: "Widget"},
+		Kind: types.Struct,
+	}
+	inChan := &types.Type{Name: types.Name{Package: "example.com/internal", Name: "Events"}, Kind: types.Chan}
+	outChan := &types.Type{Name: types.Name{Package: "example.com/v1", Name: "Events"}, Kind: types.Chan}
+
+	r := NewFallbackReport()
+	g := NewGenConversion("", "example.com/internal", "example.com/v1", conversionFuncMap{}, nil, nil, r).(*genConversion)
+	c := &generator.Context{Namers: g.Namers(nil)}
+	sw := generator.NewSnippetWriter(&bytes.Buffer{}, c, "$", "$")
+
+	// The unsupported type converted after the struct must not be
+	// attributed to the last field of the struct.
+	g.generateConversion(inStruct, outStruct, sw)
+	g.generateConversion(inChan, outChan, sw)
+	if err := sw.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := strings.Join([]string{
+		"example.com/internal.Events -> example.com/v1.Events: type example.com/internal.Events is unsupported",
+		"example.com/internal.Widget -> example.com/v1.Widget, Legacy: does not exist in peer-type",
+		"",
+	}, "\n")
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
@@ -41,7 +41,10 @@ limitations under the License.
 // warning comment about that field.  The generated conversion
 // functions use standard value assignment wherever possible.  For
 // compound types, the generated conversion functions call the
-// `Convert...` functions for the subsidiary types.
+// `Convert...` functions for the subsidiary types.  Named slice and map
+// types (e.g. `type Things []Thing`) get conversion functions of their own,
+// which convert element-wise through the `Convert...` functions of the
+// element types.
 //
 // For each pair of types `conversion-gen` will also generate a
 // function named
@@ -86,16 +89,28 @@ limitations under the License.
 // packages (AKA peer packages) consists of the ones specified in the
 // `k8s:conversion-gen` tags PLUS any specified in the
 // `--base-peer-dirs` and `--extra-peer-dirs` flags on the command
-// line.
+// line.  Peers listed in `--export-data-peer-dirs` are read from the
+// compiled export data in the build cache instead of being parsed and
+// type-checked from source, which is much faster for large dependencies
+// such as k8s.io/api.
 //
 // When generating for a package, individual types or fields of structs may opt
 // out of Conversion generation by specifying a comment on the of the form:
 //
 //	// +k8s:conversion-gen=false
+//
+// Performance-sensitive callers can verify that their conversions are fully
+// code-generated.  The `--fallback-report-file` flag writes a report of every
+// field which the generated functions do not convert themselves (and which
+// therefore needs a hand-written conversion), and the
+// `--benchmark-output-file` flag emits a test file next to the generated
+// conversions with one benchmark per generated Convert_... function.
 package main
 
 import (
 	"flag"
+	"io"
+	"os"
 
 	"github.com/spf13/pflag"
 	"k8s.io/klog/v2"
@@ -119,8 +134,9 @@ func main() {
 		klog.Fatalf("Error: %v", err)
 	}
 
+	report := generators.NewFallbackReport()
 	myTargets := func(context *generator.Context) []generator.Target {
-		return generators.GetTargets(context, args)
+		return generators.GetTargets(context, args, report)
 	}
 
 	// Run it.
@@ -133,5 +149,26 @@ func main() {
 	); err != nil {
 		klog.Fatalf("Error: %v", err)
 	}
+	if len(args.FallbackReportFile) > 0 {
+		if err := writeReport(args.FallbackReportFile, report); err != nil {
+			klog.Fatalf("Error writing fallback report: %v", err)
+		}
+	}
 	klog.V(2).Info("Completed successfully.")
 }
+
+func writeReport(filename string, report io.WriterTo) error {
+	if filename == "-" {
+		_, err := report.WriteTo(os.Stdout)
+		return err
+	}
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if _, err := report.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
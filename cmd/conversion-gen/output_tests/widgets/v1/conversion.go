@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"math"
+
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/code-generator/cmd/conversion-gen/output_tests/widgets"
+)
+
+func Convert_widgets_Widget_To_v1_Widget(in *widgets.Widget, out *Widget, s conversion.Scope) error {
+	return autoConvert_widgets_Widget_To_v1_Widget(in, out, s)
+}
+
+func Convert_v1_Scale_To_widgets_Scale(in *Scale, out *widgets.Scale, s conversion.Scope) error {
+	out.Factor = float64(in.Percent) / 100
+	return s.Convert(&in.Part, &out.Part)
+}
+
+func Convert_widgets_Scale_To_v1_Scale(in *widgets.Scale, out *Scale, s conversion.Scope) error {
+	out.Percent = int32(math.Round(in.Factor * 100))
+	return s.Convert(&in.Part, &out.Part)
+}
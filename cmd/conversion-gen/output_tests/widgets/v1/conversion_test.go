@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/dump"
+	"k8s.io/code-generator/cmd/conversion-gen/output_tests/widgets"
+)
+
+func TestRoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	// A nil Count is zero in the internal Part, so it does not round trip.
+	fuzzer := fuzz.New().NilChance(0).NumElements(0, 2)
+	for i := 0; i < 1000; i++ {
+		original := &Widget{}
+		fuzzer.Fuzz(original)
+
+		internal := &widgets.Widget{}
+		if err := scheme.Convert(original, internal, nil); err != nil {
+			t.Fatalf("converting to internal: %v", err)
+		}
+		roundTripped := &Widget{}
+		if err := scheme.Convert(internal, roundTripped, nil); err != nil {
+			t.Fatalf("converting from internal: %v", err)
+		}
+		if !equality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("round trip changed the widget:\n\n  original = %s\n\n  roundTripped = %s", dump.Pretty(original), dump.Pretty(roundTripped))
+		}
+	}
+}
+
+// TestBenchmarks runs the generated benchmarks, whose scope must support the
+// conversions using it, like Scale's.
+func TestBenchmarks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the benchmarks in short mode")
+	}
+	for name, benchmark := range map[string]func(*testing.B){
+		"v1.Part -> widgets.Part":               BenchmarkConvert_v1_Part_To_widgets_Part,
+		"widgets.Part -> v1.Part":               BenchmarkConvert_widgets_Part_To_v1_Part,
+		"v1.Parts -> widgets.Parts":             BenchmarkConvert_v1_Parts_To_widgets_Parts,
+		"widgets.Parts -> v1.Parts":             BenchmarkConvert_widgets_Parts_To_v1_Parts,
+		"v1.PartsByName -> widgets.PartsByName": BenchmarkConvert_v1_PartsByName_To_widgets_PartsByName,
+		"widgets.PartsByName -> v1.PartsByName": BenchmarkConvert_widgets_PartsByName_To_v1_PartsByName,
+		"v1.Widget -> widgets.Widget":           BenchmarkConvert_v1_Widget_To_widgets_Widget,
+		"v1.WidgetSpec -> widgets.WidgetSpec":   BenchmarkConvert_v1_WidgetSpec_To_widgets_WidgetSpec,
+		"widgets.WidgetSpec -> v1.WidgetSpec":   BenchmarkConvert_widgets_WidgetSpec_To_v1_WidgetSpec,
+	} {
+		if result := testing.Benchmark(benchmark); result.N == 0 {
+			t.Errorf("%s failed", name)
+		}
+	}
+}
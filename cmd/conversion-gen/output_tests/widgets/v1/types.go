@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+type Widget struct {
+	Name   string
+	Spec   WidgetSpec
+	Parts  Parts
+	ByName PartsByName
+}
+
+type WidgetSpec struct {
+	Replicas int32
+	Labels   map[string]string
+	Scale    Scale
+}
+
+// Scale is converted by hand, converting its Part through the scope.
+type Scale struct {
+	Percent int32
+	Part    Part
+}
+
+type Parts []Part
+
+type PartsByName map[string]Part
+
+type Part struct {
+	ID    string
+	Count *int32
+}
@@ -18,6 +18,7 @@ package args
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/pflag"
 )
@@ -26,6 +27,9 @@ type Args struct {
 	OutputFile   string
 	BoundingDirs []string // Only deal with types rooted under these dirs.
 	GoHeaderFile string
+	Optimize     bool // Copy plain old data by assignment and copy() rather than element by element.
+	WithTests    bool // Also generate tests and benchmarks of the deepcopy functions.
+	Analyze      bool // Only report the problems of the generation, without generating.
 }
 
 // New returns default arguments for the generator.
@@ -41,6 +45,17 @@ func (args *Args) AddFlags(fs *pflag.FlagSet) {
 		"Comma-separated list of import paths which bound the types for which deep-copies will be generated.")
 	fs.StringVar(&args.GoHeaderFile, "go-header-file", "",
 		"the path to a file containing boilerplate header text; the string \"YEAR\" will be replaced with the current 4-digit year")
+	fs.BoolVar(&args.Optimize, "optimize", args.Optimize,
+		"copy arrays and structs without references, and slices, maps and pointers of those, by assignment and copy() rather than element by element")
+	fs.BoolVar(&args.WithTests, "with-tests", args.WithTests,
+		"also generate a test file, named after --output-file, checking that copies of fuzzed objects are equal to them but share no pointers with them, and benchmarking DeepCopy")
+	fs.BoolVar(&args.Analyze, "analyze", args.Analyze,
+		"do not generate anything, but report the types of the input packages whose deepcopy functions cannot be generated, or would copy incorrectly, with the tags fixing them, on standard error, and exit with status 1 if there are any")
+}
+
+// TestFile returns the name of the test file generated with --with-tests.
+func (args *Args) TestFile() string {
+	return strings.TrimSuffix(args.OutputFile, ".go") + "_test.go"
 }
 
 // Validate checks the given arguments.
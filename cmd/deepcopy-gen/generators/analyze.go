@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"fmt"
+	gotypes "go/types"
+	"sort"
+
+	"golang.org/x/tools/go/packages"
+	"k8s.io/code-generator/cmd/deepcopy-gen/args"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// Analyze returns the problems which would make the generation of the
+// deepcopy functions of the input packages fail, or copy values incorrectly,
+// with their reasons and the tags fixing them, without generating anything.
+// Every package is analyzed as if it requested generation for all its types.
+func Analyze(context *generator.Context, args *args.Args) []string {
+	var findings []string
+	builtPackages := map[string]*gotypes.Package{}
+	for _, i := range context.Inputs {
+		pkg := context.Universe[i]
+		if pkg == nil {
+			continue
+		}
+		ptag := extractEnabledTag(pkg.Comments)
+		allTypes := ptag == nil || ptag.value == tagValuePackage
+		g := NewGenDeepCopy(args.OutputFile, pkg.Path, nil, allTypes, false, false, args.Optimize).(*genDeepCopy)
+		g.context = context
+		g.copyFuncs = loadCopyFuncs(context, pkg)
+		g.builtPackages = builtPackages
+
+		var names []string
+		for name := range pkg.Types {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			t := pkg.Types[name]
+			if ttag := extractEnabledTypeTag(t); ttag != nil && ttag.value == "true" && !copyableType(t) {
+				findings = append(findings, fmt.Sprintf("%v: requested deepcopy generation, but is private or neither a struct nor a named type; remove +%s=true", t, tagEnabledName))
+				continue
+			}
+			if !copyableType(t) || !g.needsGeneration(t) {
+				continue
+			}
+			findings = append(findings, g.analyzeType(t)...)
+		}
+	}
+	return findings
+}
+
+// analyzeType returns the problems of the generation of the deepcopy
+// functions of t, a type of the target package.
+func (g *genDeepCopy) analyzeType(t *types.Type) []string {
+	if deepCopyMethodOrDie(t) != nil || deepCopyIntoMethodOrDie(t) != nil {
+		return nil
+	}
+	ut := underlyingType(t)
+	if ut.Kind != types.Struct {
+		return g.analyzeMember(t.Name.String(), t, nil, map[*types.Type]bool{})
+	}
+	if len(ut.TypeParams) > 0 {
+		// Type parameters are checked by go/types when generating.
+		return nil
+	}
+	var findings []string
+	for _, m := range ut.Members {
+		if isSkippedField(m) || isShallowField(m) {
+			continue
+		}
+		path := t.Name.String() + "." + m.Name
+		findings = append(findings, g.analyzeMember(path, m.Type, extractImplementationsTag(m), map[*types.Type]bool{})...)
+	}
+	return findings
+}
+
+// hasDeepCopyInto returns whether t, a struct of another package, has a
+// DeepCopyInto method, generated or not. Unlike gengo, go/types loads the
+// generated files.
+func (g *genDeepCopy) hasDeepCopyInto(t *types.Type) bool {
+	pkg, ok := g.builtPackages[t.Name.Package]
+	if !ok {
+		cfg := &packages.Config{
+			Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedImports | packages.NeedDeps,
+		}
+		pkgs, err := packages.Load(cfg, t.Name.Package)
+		if err != nil || len(pkgs) != 1 {
+			klog.Fatalf("Failed loading package %s: %v", t.Name.Package, err)
+		}
+		pkg = pkgs[0].Types
+		g.builtPackages[t.Name.Package] = pkg
+	}
+	obj := pkg.Scope().Lookup(t.Name.Name)
+	if obj == nil {
+		return false
+	}
+	m, _, _ := gotypes.LookupFieldOrMethod(gotypes.NewPointer(obj.Type()), true, pkg, "DeepCopyInto")
+	return m != nil
+}
+
+// analyzeMember returns the problems of copying values of type t, held by
+// the member at path, which lists impls in its implementations tag.
+func (g *genDeepCopy) analyzeMember(path string, t *types.Type, impls []string, visiting map[*types.Type]bool) []string {
+	if visiting[t] {
+		return nil
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	if _, ok := g.copyFunc(t); ok || deepCopyMethodOrDie(t) != nil || deepCopyIntoMethodOrDie(t) != nil {
+		return nil
+	}
+	switch t.Name.Package {
+	case "sync", "sync/atomic":
+		return []string{fmt.Sprintf("%s: copying %v copies its state, e.g. a lock; add +%s", path, t, skipFieldTagName)}
+	}
+
+	ut := underlyingType(t)
+	switch ut.Kind {
+	case types.Builtin, types.TypeParam:
+		return nil
+	case types.Pointer, types.Slice, types.Array:
+		return g.analyzeMember(path+"[]", ut.Elem, impls, visiting)
+	case types.Map:
+		if !g.isAssignable(ut.Key) {
+			return []string{fmt.Sprintf("%s: map key %v cannot be copied by assignment; use a builtin key type", path, ut.Key)}
+		}
+		return g.analyzeMember(path+"[]", ut.Elem, impls, visiting)
+	case types.Interface:
+		if len(impls) > 0 {
+			return nil
+		}
+		if ut.Name.Name == "interface{}" {
+			return []string{fmt.Sprintf("%s: %v cannot be copied; list the types it may hold with +%s", path, t, implementationsTagName)}
+		}
+		if _, ok := ut.Methods["DeepCopy"+ut.Name.Name]; !ok {
+			return []string{fmt.Sprintf("%s: interface %v has no DeepCopy%s method; add it, or list the types it may hold with +%s", path, t, ut.Name.Name, implementationsTagName)}
+		}
+		return nil
+	case types.Func, types.Chan:
+		return []string{fmt.Sprintf("%s: %v cannot be copied; add +%s", path, t, skipFieldTagName)}
+	case types.Struct:
+		if g.isAssignable(t) {
+			return nil
+		}
+		if t.Name.Package != g.targetPackage {
+			if g.hasDeepCopyInto(t) {
+				return nil
+			}
+			return []string{fmt.Sprintf("%s: %v of package %s has no deepcopy functions; declare a function copying it with +%s", path, t, t.Name.Package, copyFuncTagName)}
+		}
+		if len(ut.TypeParams) == 0 && !g.needsGeneration(t) {
+			return []string{fmt.Sprintf("%s: %v has no deepcopy functions; add +%s=true to it", path, t, tagEnabledName)}
+		}
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: unsupported type %v", path, t)}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+// needsClone returns whether a Clone method is generated for t, which is the
+// case if t is tagged as having an immutable payload.
+func needsClone(t *types.Type) bool {
+	return extractBoolTypeTag(t, immutablePayloadTagName)
+}
+
+// generateClone writes the Clone method of t, which copies the receiver at
+// the top level: the struct, and the slices and maps of its members, are
+// copied, while the objects they reference are shared.
+func (g *genDeepCopy) generateClone(t *types.Type, sw *generator.SnippetWriter) {
+	args := argsFromType(t)
+	sw.Do("// Clone is an autogenerated function, copying the receiver at the top level and sharing the\n", nil)
+	sw.Do("// objects it references, which must not be mutated. Members of the clone, and the elements of\n", nil)
+	sw.Do("// its slices and maps, may be replaced without affecting the receiver.\n", nil)
+	if isReference(t) {
+		sw.Do("func (in $.type|raw$) Clone() $.type|raw$ {\n", args)
+		sw.Do("if in == nil { return nil }\n", nil)
+		sw.Do("out := new($.type|raw$)\n", args)
+		sw.Do("{in:=&in\n", nil)
+		g.cloneContainer(t, sw)
+		sw.Do("}\n", nil)
+		sw.Do("return *out\n", nil)
+	} else {
+		sw.Do("func (in *$.type|raw$) Clone() *$.type|raw$ {\n", args)
+		sw.Do("if in == nil { return nil }\n", nil)
+		sw.Do("out := new($.type|raw$)\n", args)
+		if ut := underlyingType(t); ut.Kind == types.Struct {
+			g.copyMembers(t, sw)
+			for _, m := range g.members(g.context, t) {
+				if isSkippedField(m) || isShallowField(m) {
+					continue
+				}
+				switch underlyingType(m.Type).Kind {
+				case types.Struct:
+					if hasSkippedFields(m.Type) {
+						sw.Do("in.$.name$.DeepCopyInto(&out.$.name$)\n", generator.Args{"name": m.Name})
+					}
+				case types.Slice, types.Map:
+					args := generator.Args{"name": m.Name}
+					sw.Do("if in.$.name$ != nil {\n", args)
+					sw.Do("in, out := &in.$.name$, &out.$.name$\n", args)
+					g.cloneContainer(m.Type, sw)
+					sw.Do("}\n", nil)
+				}
+			}
+		} else {
+			sw.Do("*out = *in\n", nil)
+		}
+		sw.Do("return out\n", nil)
+	}
+	sw.Do("}\n\n", nil)
+}
+
+// cloneContainer writes a shallow copy of the slice or map in points to into
+// out. Other types are copied by assignment. Structs with skipped fields are
+// deep-copied instead.
+func (g *genDeepCopy) cloneContainer(t *types.Type, sw *generator.SnippetWriter) {
+	switch underlyingType(t).Kind {
+	case types.Slice:
+		sw.Do("*out = make($.|raw$, len(*in))\n", t)
+		if hasSkippedFields(underlyingType(t).Elem) {
+			// Skipped fields, e.g. mutexes, are never shared.
+			sw.Do("for i := range *in {\n", nil)
+			sw.Do("(*in)[i].DeepCopyInto(&(*out)[i])\n", nil)
+			sw.Do("}\n", nil)
+		} else {
+			sw.Do("copy(*out, *in)\n", nil)
+		}
+	case types.Map:
+		sw.Do("*out = make($.|raw$, len(*in))\n", t)
+		sw.Do("for key, val := range *in {\n", nil)
+		sw.Do("(*out)[key] = val\n", nil)
+		sw.Do("}\n", nil)
+	default:
+		sw.Do("*out = *in\n", nil)
+	}
+}
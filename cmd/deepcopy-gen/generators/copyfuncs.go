@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// extractCopyFuncTag returns the fully qualified names of the functions
+// declared at the package level to copy types of other packages, e.g.
+//
+//	// +k8s:deepcopy-gen:copy-func=k8s.io/my/pkg.DeepCopyInto_Thing
+func extractCopyFuncTag(comments []string) []types.Name {
+	var names []types.Name
+	for _, value := range gengo.ExtractCommentTags("+", comments)[copyFuncTagName] {
+		name := types.ParseFullyQualifiedName(value)
+		if len(name.Package) == 0 || len(name.Name) == 0 {
+			klog.Fatalf("Expected +%s=<package path>.<function>, got %q", copyFuncTagName, value)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// loadCopyFuncs returns the functions declared with copy-func tags in the
+// comments of pkg, by the type they copy. They must be of the form
+// func(in, out *Type), like DeepCopyInto.
+func loadCopyFuncs(c *generator.Context, pkg *types.Package) map[*types.Type]types.Name {
+	copyFuncs := map[*types.Type]types.Name{}
+	for _, name := range extractCopyFuncTag(pkg.Comments) {
+		if _, err := c.LoadPackages(name.Package); err != nil {
+			klog.Fatalf("+%s=%s: failed loading package: %v", copyFuncTagName, name, err)
+		}
+		fnPkg, ok := c.Universe[name.Package]
+		if !ok || fnPkg.Functions[name.Name] == nil {
+			klog.Fatalf("+%s=%s: function not found", copyFuncTagName, name)
+		}
+		f := fnPkg.Functions[name.Name]
+		if f.Underlying == nil || f.Underlying.Signature == nil {
+			klog.Fatalf("+%s=%s: function without signature", copyFuncTagName, name)
+		}
+		signature := f.Underlying.Signature
+		if signature.Receiver != nil || len(signature.Parameters) != 2 || len(signature.Results) != 0 ||
+			signature.Parameters[0].Type.Kind != types.Pointer || signature.Parameters[0].Type != signature.Parameters[1].Type {
+			klog.Fatalf("+%s=%s: expected a function of the form func(in, out *Type)", copyFuncTagName, name)
+		}
+		key := signature.Parameters[0].Type.Elem
+		if existing, ok := copyFuncs[key]; ok && existing != name {
+			klog.Fatalf("+%s=%s: %v already has the copy function %v", copyFuncTagName, name, key, existing)
+		}
+		copyFuncs[key] = name
+		klog.V(6).Infof("found copy function for %s from %s", key.Name, name)
+	}
+	return copyFuncs
+}
+
+// copyFunc returns the function declared to copy t, and whether there is one.
+func (g *genDeepCopy) copyFunc(t *types.Type) (*types.Type, bool) {
+	name, ok := g.copyFuncs[t]
+	if !ok {
+		return nil, false
+	}
+	return types.Ref(name.Package, name.Name), true
+}
+
+// doCopyFunc generates the call of the function declared to copy t, copying
+// the value in points to into the value out points to.
+func (g *genDeepCopy) doCopyFunc(t *types.Type, in, out string, sw *generator.SnippetWriter) {
+	fn, _ := g.copyFunc(t)
+	sw.Do("$.fn|raw$($.in$, $.out$)\n", generator.Args{
+		"fn":  fn,
+		"in":  in,
+		"out": out,
+	})
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// needsCopyOnWrite returns whether a copy-on-write wrapper is generated for
+// t, which is the case if t is tagged with copy-on-write.
+func needsCopyOnWrite(t *types.Type) bool {
+	if !extractBoolTypeTag(t, copyOnWriteTagName) {
+		return false
+	}
+	if t.Kind != types.Struct || len(t.TypeParams) > 0 {
+		klog.Fatalf("Type %v: %s is only supported on non-generic structs", t, copyOnWriteTagName)
+	}
+	return true
+}
+
+// generateCopyOnWrite writes the <Type>CopyOnWrite wrapper of t. The
+// wrappers sharing a value count their references to it, and the first
+// wrapper mutating a shared value copies it with DeepCopyInto.
+func (g *genDeepCopy) generateCopyOnWrite(t *types.Type, sw *generator.SnippetWriter) {
+	args := argsFromType(t).
+		With("wrapper", t.Name.Name+"CopyOnWrite").
+		With("Int32", types.Ref("sync/atomic", "Int32"))
+	sw.Do("// $.wrapper$ is an autogenerated copy-on-write wrapper of $.type|raw$. Copies of the wrapper\n", args)
+	sw.Do("// share the wrapped value, which is deep-copied by the first of them mutating it while shared.\n", nil)
+	sw.Do("// A wrapper is not safe for concurrent use, but its copies can be used concurrently.\n", nil)
+	sw.Do("type $.wrapper$ struct {\n", args)
+	sw.Do("value *$.type|raw$\n", args)
+	sw.Do("refs *$.Int32|raw$\n", args)
+	sw.Do("}\n\n", nil)
+
+	sw.Do("// New$.wrapper$ wraps in, which must not be mutated anymore other than through the wrapper.\n", args)
+	sw.Do("func New$.wrapper$(in *$.type|raw$) *$.wrapper$ {\n", args)
+	sw.Do("refs := new($.Int32|raw$)\n", args)
+	sw.Do("refs.Store(1)\n", nil)
+	sw.Do("return &$.wrapper${value: in, refs: refs}\n", args)
+	sw.Do("}\n\n", nil)
+
+	sw.Do("// Get returns the wrapped value, which must not be mutated.\n", nil)
+	sw.Do("func (w *$.wrapper$) Get() *$.type|raw$ {\n", args)
+	sw.Do("return w.value\n", nil)
+	sw.Do("}\n\n", nil)
+
+	sw.Do("// Copy returns a wrapper sharing the value of w until either of them mutates it.\n", nil)
+	sw.Do("func (w *$.wrapper$) Copy() *$.wrapper$ {\n", args)
+	sw.Do("w.refs.Add(1)\n", nil)
+	sw.Do("return &$.wrapper${value: w.value, refs: w.refs}\n", args)
+	sw.Do("}\n\n", nil)
+
+	sw.Do("// Mutable returns the wrapped value for mutation, deep-copying it first if it is shared.\n", nil)
+	sw.Do("func (w *$.wrapper$) Mutable() *$.type|raw$ {\n", args)
+	sw.Do("if w.refs.Load() > 1 {\n", nil)
+	sw.Do("out := new($.type|raw$)\n", args)
+	sw.Do("w.value.DeepCopyInto(out)\n", nil)
+	sw.Do("w.refs.Add(-1)\n", nil)
+	sw.Do("w.value, w.refs = out, new($.Int32|raw$)\n", args)
+	sw.Do("w.refs.Store(1)\n", nil)
+	sw.Do("}\n", nil)
+	sw.Do("return w.value\n", nil)
+	sw.Do("}\n\n", nil)
+
+	sw.Do("// Release drops the reference of w to its value. w must not be used anymore.\n", nil)
+	sw.Do("func (w *$.wrapper$) Release() {\n", args)
+	sw.Do("w.refs.Add(-1)\n", nil)
+	sw.Do("w.value, w.refs = nil, nil\n", nil)
+	sw.Do("}\n\n", nil)
+}
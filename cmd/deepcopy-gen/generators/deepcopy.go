@@ -18,6 +18,7 @@ package generators
 
 import (
 	"fmt"
+	gotypes "go/types"
 	"io"
 	"path"
 	"sort"
@@ -36,6 +37,13 @@ const (
 	tagEnabledName              = "k8s:deepcopy-gen"
 	interfacesTagName           = tagEnabledName + ":interfaces"
 	interfacesNonPointerTagName = tagEnabledName + ":nonpointer-interfaces" // attach the DeepCopy<Interface> methods to the
+	implementationsTagName      = tagEnabledName + ":implementations"
+	immutablePayloadTagName     = tagEnabledName + ":immutable-payload"
+	skipFieldTagName            = tagEnabledName + ":skip-field"
+	copyOnWriteTagName          = tagEnabledName + ":copy-on-write"
+	pooledTagName               = tagEnabledName + ":pooled"
+	copyFuncTagName             = tagEnabledName + ":copy-func"
+	shallowTagName              = tagEnabledName + ":shallow"
 )
 
 // Known values for the comment tag.
@@ -43,8 +51,9 @@ const tagValuePackage = "package"
 
 // enabledTagValue holds parameters from a tagName tag.
 type enabledTagValue struct {
-	value    string
-	register bool
+	value     string
+	register  bool
+	deepEqual bool
 }
 
 func extractEnabledTypeTag(t *types.Type) *enabledTagValue {
@@ -52,6 +61,20 @@ func extractEnabledTypeTag(t *types.Type) *enabledTagValue {
 	return extractEnabledTag(comments)
 }
 
+// extractBoolTypeTag returns the value of the tag of t with the given name,
+// which must be true or false, and defaults to false.
+func extractBoolTypeTag(t *types.Type, name string) bool {
+	comments := append(append([]string{}, t.SecondClosestCommentLines...), t.CommentLines...)
+	values := gengo.ExtractCommentTags("+", comments)[name]
+	if len(values) == 0 {
+		return false
+	}
+	if len(values) > 1 || (values[0] != "true" && values[0] != "false") {
+		klog.Fatalf("Type %v: unsupported %s value: %q", t, name, values)
+	}
+	return values[0] == "true"
+}
+
 func extractEnabledTag(comments []string) *enabledTagValue {
 	tagVals := gengo.ExtractCommentTags("+", comments)[tagEnabledName]
 	if tagVals == nil {
@@ -86,6 +109,10 @@ func extractEnabledTag(comments []string) *enabledTagValue {
 			if v != "false" {
 				tag.register = true
 			}
+		case "deep-equal":
+			if v != "false" {
+				tag.deepEqual = true
+			}
 		default:
 			klog.Fatalf("Unsupported %s param: %q", tagEnabledName, parts[i])
 		}
@@ -135,6 +162,7 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 	}
 
 	targets := []generator.Target{}
+	context.FileTypes[deepCopyTestsFileType] = deepCopyTestsFile{generator.NewGoFile()}
 
 	for _, i := range context.Inputs {
 		klog.V(3).Infof("Considering pkg %q", i)
@@ -144,13 +172,15 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 		ptag := extractEnabledTag(pkg.Comments)
 		ptagValue := ""
 		ptagRegister := false
+		ptagDeepEqual := false
 		if ptag != nil {
 			ptagValue = ptag.value
 			if ptagValue != tagValuePackage {
 				klog.Fatalf("Package %v: unsupported %s value: %q", i, tagEnabledName, ptagValue)
 			}
 			ptagRegister = ptag.register
-			klog.V(3).Infof("  tag.value: %q, tag.register: %t", ptagValue, ptagRegister)
+			ptagDeepEqual = ptag.deepEqual
+			klog.V(3).Infof("  tag.value: %q, tag.register: %t, tag.deep-equal: %t", ptagValue, ptagRegister, ptagDeepEqual)
 		} else {
 			klog.V(3).Infof("  no tag")
 		}
@@ -192,9 +222,12 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 						return t.Name.Package == pkg.Path
 					},
 					GeneratorsFunc: func(c *generator.Context) (generators []generator.Generator) {
-						return []generator.Generator{
-							NewGenDeepCopy(args.OutputFile, pkg.Path, boundingDirs, (ptagValue == tagValuePackage), ptagRegister),
+						deepCopy := NewGenDeepCopy(args.OutputFile, pkg.Path, boundingDirs, (ptagValue == tagValuePackage), ptagRegister, ptagDeepEqual, args.Optimize)
+						generators = []generator.Generator{deepCopy}
+						if args.WithTests {
+							generators = append(generators, NewGenDeepCopyTests(args.TestFile(), pkg.Path, deepCopy.(*genDeepCopy)))
 						}
+						return generators
 					},
 				})
 		}
@@ -209,21 +242,49 @@ type genDeepCopy struct {
 	boundingDirs  []string
 	allTypes      bool
 	registerTypes bool
+	deepEqual     bool
+	optimize      bool
 	imports       namer.ImportTracker
 	typesForInit  []*types.Type
+	// inlining holds the types whose equality is being written inline, to
+	// detect recursive types without DeepEqual methods.
+	inlining map[*types.Type]bool
+	// context is the context of the type being generated.
+	context *generator.Context
+	// goPackages holds the go/types packages loaded for generic types.
+	goPackages map[string]*gotypes.Package
+	// typeParamCopies holds whether type parameters are copied with DeepCopy.
+	typeParamCopies map[*types.Type]bool
+	// implementations holds the implementations listed in the implementations
+	// tag of the member being generated, named implementationsOf.
+	implementations   []*types.Type
+	implementationsOf string
+	// generatedTypes holds the non-generic types deepcopy functions were
+	// generated for.
+	generatedTypes []*types.Type
+	// copyFuncs holds the functions declared to copy types of other packages.
+	copyFuncs map[*types.Type]types.Name
+	// builtPackages holds the go/types packages loaded with their generated
+	// files by Analyze.
+	builtPackages map[string]*gotypes.Package
 }
 
-func NewGenDeepCopy(outputFilename, targetPackage string, boundingDirs []string, allTypes, registerTypes bool) generator.Generator {
+func NewGenDeepCopy(outputFilename, targetPackage string, boundingDirs []string, allTypes, registerTypes, deepEqual, optimize bool) generator.Generator {
 	return &genDeepCopy{
 		GoGenerator: generator.GoGenerator{
 			OutputFilename: outputFilename,
 		},
-		targetPackage: targetPackage,
-		boundingDirs:  boundingDirs,
-		allTypes:      allTypes,
-		registerTypes: registerTypes,
-		imports:       generator.NewImportTrackerForPackage(targetPackage),
-		typesForInit:  make([]*types.Type, 0),
+		targetPackage:   targetPackage,
+		boundingDirs:    boundingDirs,
+		allTypes:        allTypes,
+		registerTypes:   registerTypes,
+		deepEqual:       deepEqual,
+		optimize:        optimize,
+		inlining:        map[*types.Type]bool{},
+		goPackages:      map[string]*gotypes.Package{},
+		typeParamCopies: map[*types.Type]bool{},
+		imports:         generator.NewImportTrackerForPackage(targetPackage),
+		typesForInit:    make([]*types.Type, 0),
 	}
 }
 
@@ -423,6 +484,9 @@ func argsFromType(ts ...*types.Type) generator.Args {
 }
 
 func (g *genDeepCopy) Init(c *generator.Context, w io.Writer) error {
+	if pkg := c.Universe[g.targetPackage]; pkg != nil {
+		g.copyFuncs = loadCopyFuncs(c, pkg)
+	}
 	return nil
 }
 
@@ -550,6 +614,10 @@ func (g *genDeepCopy) GenerateType(c *generator.Context, t *types.Type, w io.Wri
 		return nil
 	}
 	klog.V(2).Infof("Generating deepcopy functions for type %v", t)
+	g.context = c
+	if len(t.TypeParams) == 0 {
+		g.generatedTypes = append(g.generatedTypes, t)
+	}
 
 	sw := generator.NewSnippetWriter(w, c, "$", "$")
 	args := argsFromType(t)
@@ -618,6 +686,22 @@ func (g *genDeepCopy) GenerateType(c *generator.Context, t *types.Type, w io.Wri
 		}
 	}
 
+	if g.needsDeepEqual(t) {
+		g.generateDeepEqual(c, t, sw)
+	}
+
+	if needsClone(t) {
+		g.generateClone(t, sw)
+	}
+
+	if needsCopyOnWrite(t) {
+		g.generateCopyOnWrite(t, sw)
+	}
+
+	if needsPooled(t) {
+		g.generatePooled(t, sw)
+	}
+
 	return sw.Error()
 }
 
@@ -649,6 +733,8 @@ func (g *genDeepCopy) generateFor(t *types.Type, sw *generator.SnippetWriter) {
 		f = g.doStruct
 	case types.Pointer:
 		f = g.doPointer
+	case types.TypeParam:
+		f = g.doTypeParam
 	case types.Interface:
 		// interfaces are handled in-line in the other cases
 		klog.Fatalf("Hit an interface type %v. This should never happen.", t)
@@ -683,14 +769,19 @@ func (g *genDeepCopy) doMap(t *types.Type, sw *generator.SnippetWriter) {
 		return
 	}
 
-	if !ut.Key.IsAssignable() {
+	if !g.isAssignable(ut.Key) {
 		klog.Fatalf("Hit an unsupported type %v for: %v", uet, t)
 	}
 
 	sw.Do("*out = make($.|raw$, len(*in))\n", t)
 	sw.Do("for key, val := range *in {\n", nil)
 	dc, dci := deepCopyMethodOrDie(ut.Elem), deepCopyIntoMethodOrDie(ut.Elem)
+	_, cf := g.copyFunc(ut.Elem)
 	switch {
+	case cf:
+		sw.Do("var outVal $.|raw$\n", ut.Elem)
+		g.doCopyFunc(ut.Elem, "&val", "&outVal", sw)
+		sw.Do("(*out)[key] = outVal\n", nil)
 	case dc != nil || dci != nil:
 		// Note: a DeepCopy exists because it is added if DeepCopyInto is manually defined
 		leftPointer := ut.Elem.Kind == types.Pointer
@@ -708,8 +799,14 @@ func (g *genDeepCopy) doMap(t *types.Type, sw *generator.SnippetWriter) {
 		}
 	case ut.Elem.IsAnonymousStruct(): // not uet here because it needs type cast
 		sw.Do("(*out)[key] = val\n", nil)
-	case uet.IsAssignable():
+	case g.copiedByAssignment(uet):
 		sw.Do("(*out)[key] = val\n", nil)
+	case uet.Kind == types.TypeParam:
+		sw.Do("(*out)[key] = val.DeepCopy()\n", nil)
+	case uet.Kind == types.Interface && g.implementations != nil:
+		sw.Do("if val == nil {(*out)[key]=nil} else {\n", nil)
+		g.doImplementations(g.implementationsOf, g.implementations, "val", "(*out)[key]", sw)
+		sw.Do("}\n", nil)
 	case uet.Kind == types.Interface:
 		// Note: do not generate code that won't compile as `DeepCopyinterface{}()` is not a valid function
 		if uet.Name.Name == "interface{}" {
@@ -748,12 +845,16 @@ func (g *genDeepCopy) doSlice(t *types.Type, sw *generator.SnippetWriter) {
 	}
 
 	sw.Do("*out = make($.|raw$, len(*in))\n", t)
-	if deepCopyMethodOrDie(ut.Elem) != nil || deepCopyIntoMethodOrDie(ut.Elem) != nil {
+	if _, ok := g.copyFunc(ut.Elem); ok {
+		sw.Do("for i := range *in {\n", nil)
+		g.doCopyFunc(ut.Elem, "&(*in)[i]", "&(*out)[i]", sw)
+		sw.Do("}\n", nil)
+	} else if deepCopyMethodOrDie(ut.Elem) != nil || deepCopyIntoMethodOrDie(ut.Elem) != nil {
 		sw.Do("for i := range *in {\n", nil)
 		// Note: a DeepCopyInto exists because it is added if DeepCopy is manually defined
 		sw.Do("(*in)[i].DeepCopyInto(&(*out)[i])\n", nil)
 		sw.Do("}\n", nil)
-	} else if uet.Kind == types.Builtin || uet.IsAssignable() {
+	} else if uet.Kind == types.Builtin || g.copiedByAssignment(uet) {
 		sw.Do("copy(*out, *in)\n", nil)
 	} else {
 		sw.Do("for i := range *in {\n", nil)
@@ -762,6 +863,10 @@ func (g *genDeepCopy) doSlice(t *types.Type, sw *generator.SnippetWriter) {
 			sw.Do("in, out := &(*in)[i], &(*out)[i]\n", nil)
 			g.generateFor(ut.Elem, sw)
 			sw.Do("}\n", nil)
+		} else if uet.Kind == types.Interface && g.implementations != nil {
+			sw.Do("if (*in)[i] != nil {\n", nil)
+			g.doImplementations(g.implementationsOf, g.implementations, "(*in)[i]", "(*out)[i]", sw)
+			sw.Do("}\n", nil)
 		} else if uet.Kind == types.Interface {
 			// Note: do not generate code that won't compile as `DeepCopyinterface{}()` is not a valid function
 			if uet.Name.Name == "interface{}" {
@@ -775,6 +880,8 @@ func (g *genDeepCopy) doSlice(t *types.Type, sw *generator.SnippetWriter) {
 			sw.Do("}\n", nil)
 		} else if uet.Kind == types.Struct {
 			sw.Do("(*in)[i].DeepCopyInto(&(*out)[i])\n", nil)
+		} else if uet.Kind == types.TypeParam {
+			sw.Do("(*out)[i] = (*in)[i].DeepCopy()\n", nil)
 		} else {
 			klog.Fatalf("Hit an unsupported type %v for %v", uet, t)
 		}
@@ -785,73 +892,96 @@ func (g *genDeepCopy) doSlice(t *types.Type, sw *generator.SnippetWriter) {
 // doStruct generates code for a struct or an alias to a struct. The generated code is
 // is the same for both cases, i.e. it's the code for the underlying type.
 func (g *genDeepCopy) doStruct(t *types.Type, sw *generator.SnippetWriter) {
-	ut := underlyingType(t)
-
 	if deepCopyMethodOrDie(t) != nil || deepCopyIntoMethodOrDie(t) != nil {
 		sw.Do("*out = in.DeepCopy()\n", nil)
 		return
 	}
 
 	// Simple copy covers a lot of cases.
-	sw.Do("*out = *in\n", nil)
+	g.copyMembers(t, sw)
 
 	// Now fix-up fields as needed.
-	for _, m := range ut.Members {
-		ft := m.Type
-		uft := underlyingType(ft)
-
-		args := generator.Args{
-			"type": ft,
-			"kind": ft.Kind,
-			"name": m.Name,
-		}
-		dc, dci := deepCopyMethodOrDie(ft), deepCopyIntoMethodOrDie(ft)
-		switch {
-		case dc != nil || dci != nil:
-			// Note: a DeepCopyInto exists because it is added if DeepCopy is manually defined
-			leftPointer := ft.Kind == types.Pointer
-			rightPointer := !isReference(ft)
-			if dc != nil {
-				rightPointer = dc.Results[0].Type.Kind == types.Pointer
-			}
-			if leftPointer == rightPointer {
-				sw.Do("out.$.name$ = in.$.name$.DeepCopy()\n", args)
-			} else if leftPointer {
-				sw.Do("x := in.$.name$.DeepCopy()\n", args)
-				sw.Do("out.$.name$ =  = &x\n", args)
-			} else {
-				sw.Do("in.$.name$.DeepCopyInto(&out.$.name$)\n", args)
-			}
-		case uft.Kind == types.Builtin:
-			// the initial *out = *in was enough
-		case uft.Kind == types.Map, uft.Kind == types.Slice, uft.Kind == types.Pointer:
-			// Fixup non-nil reference-semantic types.
-			sw.Do("if in.$.name$ != nil {\n", args)
-			sw.Do("in, out := &in.$.name$, &out.$.name$\n", args)
-			g.generateFor(ft, sw)
-			sw.Do("}\n", nil)
-		case uft.Kind == types.Array:
+	for _, m := range g.members(g.context, t) {
+		if isSkippedField(m) || isShallowField(m) {
+			continue
+		}
+		g.doMember(t, m, sw)
+	}
+}
+
+// doMember generates the fix-up of the member m of the struct t, after out
+// was assigned in.
+func (g *genDeepCopy) doMember(t *types.Type, m types.Member, sw *generator.SnippetWriter) {
+	ft := m.Type
+	uft := underlyingType(ft)
+	impls := g.memberImplementations(t, m)
+
+	args := generator.Args{
+		"type": ft,
+		"kind": ft.Kind,
+		"name": m.Name,
+	}
+	dc, dci := deepCopyMethodOrDie(ft), deepCopyIntoMethodOrDie(ft)
+	_, cf := g.copyFunc(ft)
+	switch {
+	case cf:
+		g.doCopyFunc(ft, "&in."+m.Name, "&out."+m.Name, sw)
+	case dc != nil || dci != nil:
+		// Note: a DeepCopyInto exists because it is added if DeepCopy is manually defined
+		leftPointer := ft.Kind == types.Pointer
+		rightPointer := !isReference(ft)
+		if dc != nil {
+			rightPointer = dc.Results[0].Type.Kind == types.Pointer
+		}
+		if leftPointer == rightPointer {
+			sw.Do("out.$.name$ = in.$.name$.DeepCopy()\n", args)
+		} else if leftPointer {
+			sw.Do("x := in.$.name$.DeepCopy()\n", args)
+			sw.Do("out.$.name$ =  = &x\n", args)
+		} else {
+			sw.Do("in.$.name$.DeepCopyInto(&out.$.name$)\n", args)
+		}
+	case uft.Kind == types.Builtin:
+		// the initial *out = *in was enough
+	case uft.Kind == types.TypeParam:
+		if g.typeParamCopies[uft] {
+			sw.Do("out.$.name$ = in.$.name$.DeepCopy()\n", args)
+		}
+	case uft.Kind == types.Map, uft.Kind == types.Slice, uft.Kind == types.Pointer:
+		// Fixup non-nil reference-semantic types.
+		sw.Do("if in.$.name$ != nil {\n", args)
+		sw.Do("in, out := &in.$.name$, &out.$.name$\n", args)
+		g.implementations, g.implementationsOf = impls, t.Name.Name+"."+m.Name
+		g.generateFor(ft, sw)
+		g.implementations, g.implementationsOf = nil, ""
+		sw.Do("}\n", nil)
+	case g.optimize && g.isPlainOldData(ft):
+		// the initial *out = *in was enough
+	case uft.Kind == types.Array:
+		sw.Do("out.$.name$ = in.$.name$\n", args)
+	case uft.Kind == types.Struct:
+		if g.isAssignable(ft) {
 			sw.Do("out.$.name$ = in.$.name$\n", args)
-		case uft.Kind == types.Struct:
-			if ft.IsAssignable() {
-				sw.Do("out.$.name$ = in.$.name$\n", args)
-			} else {
-				sw.Do("in.$.name$.DeepCopyInto(&out.$.name$)\n", args)
-			}
-		case uft.Kind == types.Interface:
-			// Note: do not generate code that won't compile as `DeepCopyinterface{}()` is not a valid function
-			if uft.Name.Name == "interface{}" {
-				klog.Fatalf("DeepCopy of %q is unsupported. Instead, use named interfaces with DeepCopy<named-interface> as one of the methods.", uft.Name.Name)
-			}
-			sw.Do("if in.$.name$ != nil {\n", args)
-			// Note: if t.Elem has been an alias "J" of an interface "I" in Go, we will see it
-			// as kind Interface of name "J" here, i.e. generate val.DeepCopyJ(). The golang
-			// parser does not give us the underlying interface name. So we cannot do any better.
-			sw.Do(fmt.Sprintf("out.$.name$ = in.$.name$.DeepCopy%s()\n", uft.Name.Name), args)
-			sw.Do("}\n", nil)
-		default:
-			klog.Fatalf("Hit an unsupported type '%v' for '%v', from %v.%v", uft, ft, t, m.Name)
+		} else {
+			sw.Do("in.$.name$.DeepCopyInto(&out.$.name$)\n", args)
+		}
+	case uft.Kind == types.Interface && impls != nil:
+		sw.Do("if in.$.name$ != nil {\n", args)
+		g.doImplementations(t.Name.Name+"."+m.Name, impls, "in."+m.Name, "out."+m.Name, sw)
+		sw.Do("}\n", nil)
+	case uft.Kind == types.Interface:
+		// Note: do not generate code that won't compile as `DeepCopyinterface{}()` is not a valid function
+		if uft.Name.Name == "interface{}" {
+			klog.Fatalf("DeepCopy of %q is unsupported. Instead, use named interfaces with DeepCopy<named-interface> as one of the methods.", uft.Name.Name)
 		}
+		sw.Do("if in.$.name$ != nil {\n", args)
+		// Note: if t.Elem has been an alias "J" of an interface "I" in Go, we will see it
+		// as kind Interface of name "J" here, i.e. generate val.DeepCopyJ(). The golang
+		// parser does not give us the underlying interface name. So we cannot do any better.
+		sw.Do(fmt.Sprintf("out.$.name$ = in.$.name$.DeepCopy%s()\n", uft.Name.Name), args)
+		sw.Do("}\n", nil)
+	default:
+		klog.Fatalf("Hit an unsupported type '%v' for '%v', from %v.%v", uft, ft, t, m.Name)
 	}
 }
 
@@ -862,7 +992,11 @@ func (g *genDeepCopy) doPointer(t *types.Type, sw *generator.SnippetWriter) {
 	uet := underlyingType(ut.Elem)
 
 	dc, dci := deepCopyMethodOrDie(ut.Elem), deepCopyIntoMethodOrDie(ut.Elem)
+	_, cf := g.copyFunc(ut.Elem)
 	switch {
+	case cf:
+		sw.Do("*out = new($.Elem|raw$)\n", ut)
+		g.doCopyFunc(ut.Elem, "*in", "*out", sw)
 	case dc != nil || dci != nil:
 		rightPointer := !isReference(ut.Elem)
 		if dc != nil {
@@ -874,9 +1008,12 @@ func (g *genDeepCopy) doPointer(t *types.Type, sw *generator.SnippetWriter) {
 			sw.Do("x := (*in).DeepCopy()\n", nil)
 			sw.Do("*out = &x\n", nil)
 		}
-	case uet.IsAssignable():
+	case g.copiedByAssignment(uet):
 		sw.Do("*out = new($.Elem|raw$)\n", ut)
 		sw.Do("**out = **in", nil)
+	case uet.Kind == types.TypeParam:
+		sw.Do("*out = new($.Elem|raw$)\n", ut)
+		sw.Do("**out = (**in).DeepCopy()\n", nil)
 	case uet.Kind == types.Map, uet.Kind == types.Slice, uet.Kind == types.Pointer:
 		sw.Do("*out = new($.Elem|raw$)\n", ut)
 		sw.Do("if **in != nil {\n", nil)
@@ -890,3 +1027,14 @@ func (g *genDeepCopy) doPointer(t *types.Type, sw *generator.SnippetWriter) {
 		klog.Fatalf("Hit an unsupported type %v for %v", uet, t)
 	}
 }
+
+// doTypeParam generates code for a type parameter, copied with the DeepCopy
+// method required by its constraint, or by assignment if its constraint only
+// allows builtin types.
+func (g *genDeepCopy) doTypeParam(t *types.Type, sw *generator.SnippetWriter) {
+	if g.typeParamCopies[t] {
+		sw.Do("*out = (*in).DeepCopy()\n", nil)
+		return
+	}
+	sw.Do("*out = *in\n", nil)
+}
@@ -590,6 +590,16 @@ func Test_extractTagParams(t *testing.T) {
 				register: false,
 			},
 		},
+		{
+			comments: []string{
+				"Human comment",
+				"+k8s:deepcopy-gen=true,deep-equal",
+			},
+			expect: &enabledTagValue{
+				value:     "true",
+				deepEqual: true,
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -694,3 +704,112 @@ func Test_extractInterfacesTag(t *testing.T) {
 		}
 	}
 }
+
+func Test_extractImplementationsTag(t *testing.T) {
+	testCases := []struct {
+		comments []string
+		expect   []string
+	}{
+		{
+			comments: []string{},
+			expect:   nil,
+		},
+		{
+			comments: []string{
+				"+k8s:deepcopy-gen:implementations=k8s.io/api/core/v1.Pod,*k8s.io/api/core/v1.Service",
+			},
+			expect: []string{
+				"k8s.io/api/core/v1.Pod",
+				"*k8s.io/api/core/v1.Service",
+			},
+		},
+		{
+			comments: []string{
+				"+k8s:deepcopy-gen:implementations=string",
+				"+k8s:deepcopy-gen:implementations=*k8s.io/api/core/v1.Service,",
+			},
+			expect: []string{
+				"string",
+				"*k8s.io/api/core/v1.Service",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		r := extractImplementationsTag(types.Member{CommentLines: tc.comments})
+		if !reflect.DeepEqual(r, tc.expect) {
+			t.Errorf("case[%d]: expected %v, got %v", i, tc.expect, r)
+		}
+	}
+}
+
+func Test_extractCopyFuncTag(t *testing.T) {
+	testCases := []struct {
+		comments []string
+		expect   []types.Name
+	}{
+		{
+			comments: []string{},
+			expect:   nil,
+		},
+		{
+			comments: []string{
+				"+k8s:deepcopy-gen:copy-func=k8s.io/my/pkg.DeepCopyInto_Thing",
+				"+k8s:deepcopy-gen:copy-func=k8s.io/my/other.CopyWidget",
+			},
+			expect: []types.Name{
+				{Package: "k8s.io/my/pkg", Name: "DeepCopyInto_Thing"},
+				{Package: "k8s.io/my/other", Name: "CopyWidget"},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		r := extractCopyFuncTag(tc.comments)
+		if !reflect.DeepEqual(r, tc.expect) {
+			t.Errorf("case[%d]: expected %v, got %v", i, tc.expect, r)
+		}
+	}
+}
+
+func Test_analyzeType(t *testing.T) {
+	mutex := &types.Type{
+		Name: types.Name{Package: "sync", Name: "Mutex"},
+		Kind: types.Struct,
+		Members: []types.Member{
+			{Name: "state", Type: types.Int32},
+		},
+	}
+	empty := &types.Type{
+		Name: types.Name{Name: "interface{}"},
+		Kind: types.Interface,
+	}
+	fn := &types.Type{
+		Name: types.Name{Name: "func()"},
+		Kind: types.Func,
+	}
+	ttest := &types.Type{
+		Name: types.Name{Package: "pkg", Name: "Ttest"},
+		Kind: types.Struct,
+		Members: []types.Member{
+			{Name: "Name", Type: types.String},
+			{Name: "Callback", Type: fn},
+			{Name: "Callbacks", Type: &types.Type{Name: types.Name{Name: "[]func()"}, Kind: types.Slice, Elem: fn}},
+			{Name: "Skipped", Type: fn, CommentLines: []string{"+k8s:deepcopy-gen:skip-field"}},
+			{Name: "Any", Type: empty},
+			{Name: "Listed", Type: empty, CommentLines: []string{"+k8s:deepcopy-gen:implementations=string"}},
+			{Name: "Lock", Type: mutex},
+		},
+	}
+
+	g := NewGenDeepCopy("", "pkg", nil, true, false, false, false).(*genDeepCopy)
+	expect := []string{
+		"pkg.Ttest.Callback: func() cannot be copied; add +k8s:deepcopy-gen:skip-field",
+		"pkg.Ttest.Callbacks[]: func() cannot be copied; add +k8s:deepcopy-gen:skip-field",
+		"pkg.Ttest.Any: interface{} cannot be copied; list the types it may hold with +k8s:deepcopy-gen:implementations",
+		"pkg.Ttest.Lock: copying sync.Mutex copies its state, e.g. a lock; add +k8s:deepcopy-gen:skip-field",
+	}
+	if got := g.analyzeType(ttest); !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected %q, got %q", expect, got)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The helpers of this file are written, after their imports, into the tests
+// generated with --with-tests, rather than imported, so that code-generator
+// does not become a test dependency of the API packages.
+
+package generators
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// checkDeepCopy fails t if out, a pointer to the deep copy of the value in
+// points to, is not semantically equal to it, nil and empty slices and maps
+// being equal, or if it shares a pointer, slice or map with it. Values of zero
+// size, which may share their address, and time locations are not checked for
+// sharing. Failures are reported under name.
+func checkDeepCopy(t testing.TB, name string, in, out interface{}) {
+	t.Helper()
+	checkDeepCopyValue(t, name, reflect.ValueOf(in).Elem(), reflect.ValueOf(out).Elem())
+}
+
+func checkDeepCopyValue(t testing.TB, path string, in, out reflect.Value) {
+	t.Helper()
+	switch in.Kind() {
+	case reflect.Pointer:
+		if in.IsNil() || out.IsNil() {
+			if in.IsNil() != out.IsNil() {
+				t.Errorf("%s: nil differs in the copy", path)
+			}
+			return
+		}
+		if in.Pointer() == out.Pointer() && in.Type().Elem().Size() > 0 && in.Type().Elem() != reflect.TypeOf(time.Location{}) {
+			t.Errorf("%s: pointer shared by the copy", path)
+			return
+		}
+		checkDeepCopyValue(t, path, in.Elem(), out.Elem())
+	case reflect.Map:
+		if in.Len() != out.Len() {
+			t.Errorf("%s: length %d differs in the copy, %d", path, in.Len(), out.Len())
+			return
+		}
+		if in.Len() == 0 {
+			return
+		}
+		if in.Pointer() == out.Pointer() {
+			t.Errorf("%s: map shared by the copy", path)
+			return
+		}
+		iter := in.MapRange()
+		for iter.Next() {
+			key := fmt.Sprintf("%s[%v]", path, iter.Key())
+			outVal := out.MapIndex(iter.Key())
+			if !outVal.IsValid() {
+				t.Errorf("%s: missing in the copy", key)
+				continue
+			}
+			checkDeepCopyValue(t, key, iter.Value(), outVal)
+		}
+	case reflect.Slice:
+		if in.Len() != out.Len() {
+			t.Errorf("%s: length %d differs in the copy, %d", path, in.Len(), out.Len())
+			return
+		}
+		if in.Len() == 0 {
+			return
+		}
+		if in.Pointer() == out.Pointer() && in.Type().Elem().Size() > 0 {
+			t.Errorf("%s: slice shared by the copy", path)
+			return
+		}
+		for i := 0; i < in.Len(); i++ {
+			checkDeepCopyValue(t, fmt.Sprintf("%s[%d]", path, i), in.Index(i), out.Index(i))
+		}
+	case reflect.Array:
+		for i := 0; i < in.Len(); i++ {
+			checkDeepCopyValue(t, fmt.Sprintf("%s[%d]", path, i), in.Index(i), out.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < in.NumField(); i++ {
+			checkDeepCopyValue(t, path+"."+in.Type().Field(i).Name, in.Field(i), out.Field(i))
+		}
+	case reflect.Interface:
+		if in.IsNil() != out.IsNil() || (!in.IsNil() && in.Elem().Type() != out.Elem().Type()) {
+			t.Errorf("%s: dynamic type differs in the copy", path)
+			return
+		}
+		if in.IsNil() {
+			return
+		}
+		checkDeepCopyValue(t, path, in.Elem(), out.Elem())
+	case reflect.Chan, reflect.Func:
+		if in.IsNil() != out.IsNil() {
+			t.Errorf("%s: nil differs in the copy", path)
+		}
+	case reflect.Bool:
+		if in.Bool() != out.Bool() {
+			t.Errorf("%s: %v differs in the copy, %v", path, in.Bool(), out.Bool())
+		}
+	case reflect.String:
+		if in.String() != out.String() {
+			t.Errorf("%s: %q differs in the copy, %q", path, in.String(), out.String())
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if in.Int() != out.Int() {
+			t.Errorf("%s: %v differs in the copy, %v", path, in.Int(), out.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if in.Uint() != out.Uint() {
+			t.Errorf("%s: %v differs in the copy, %v", path, in.Uint(), out.Uint())
+		}
+	case reflect.Float32, reflect.Float64:
+		if in.Float() != out.Float() {
+			t.Errorf("%s: %v differs in the copy, %v", path, in.Float(), out.Float())
+		}
+	}
+}
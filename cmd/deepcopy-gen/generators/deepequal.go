@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"fmt"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// equalMethod returns the signature of a method with the given name comparing
+// a value of type t to another one, nil or an error if the method does not
+// match. The correct signatures for a type T are:
+//
+//	func (t T) <name>(other T) bool
+//	func (t *T) <name>(other *T) bool
+//
+// or any combination of pointer and non-pointer receivers and parameters.
+func equalMethod(t *types.Type, name string) (*types.Signature, error) {
+	f, found := t.Methods[name]
+	if !found {
+		return nil, nil
+	}
+	if len(f.Signature.Parameters) != 1 {
+		return nil, fmt.Errorf("type %v: invalid %s signature, expected exactly one parameter", t, name)
+	}
+	if len(f.Signature.Results) != 1 || f.Signature.Results[0].Type != types.Bool {
+		return nil, fmt.Errorf("type %v: invalid %s signature, expected a bool result", t, name)
+	}
+	param := f.Signature.Parameters[0].Type
+	ptrParam := param.Kind == types.Pointer && param.Elem.Name == t.Name
+	nonPtrParam := param.Name == t.Name
+	if !ptrParam && !nonPtrParam {
+		return nil, fmt.Errorf("type %v: invalid %s signature, expected a parameter of type %s or *%s", t, name, t.Name.Name, t.Name.Name)
+	}
+	return f.Signature, nil
+}
+
+// deepEqualMethodOrDie returns the signature of a DeepEqual method, nil or
+// calls klog.Fatalf if the type does not match.
+func deepEqualMethodOrDie(t *types.Type) *types.Signature {
+	ret, err := equalMethod(t, "DeepEqual")
+	if err != nil {
+		klog.Fatal(err)
+	}
+	return ret
+}
+
+// needsDeepEqual returns whether a DeepEqual method is generated for t, a
+// type of the package being generated.
+func (g *genDeepCopy) needsDeepEqual(t *types.Type) bool {
+	if deepEqualMethodOrDie(t) != nil {
+		return false
+	}
+	if g.allTypes && g.deepEqual {
+		return true
+	}
+	tag := extractEnabledTypeTag(t)
+	return tag != nil && tag.deepEqual
+}
+
+// generatesDeepEqual returns whether deepcopy-gen generates a DeepEqual
+// method for t, in the package being generated or in any other package.
+func generatesDeepEqual(c *generator.Context, t *types.Type) bool {
+	if !copyableType(t) || deepEqualMethodOrDie(t) != nil {
+		return false
+	}
+	if tag := extractEnabledTypeTag(t); tag != nil && tag.deepEqual {
+		return true
+	}
+	pkg := c.Universe[t.Name.Package]
+	if pkg == nil {
+		return false
+	}
+	ptag := extractEnabledTag(pkg.Comments)
+	return ptag != nil && ptag.value == tagValuePackage && ptag.deepEqual
+}
+
+// equalCall returns the call of the method comparing the values in and other
+// point to, and whether there is one. The DeepEqual methods of the type,
+// defined or generated, are preferred over its Equal method.
+func equalCall(c *generator.Context, t *types.Type) (string, bool) {
+	if generatesDeepEqual(c, t) {
+		// Generated methods take the same kind of argument as their receiver.
+		if isReference(t) {
+			return "(*in).DeepEqual(*other)", true
+		}
+		return "in.DeepEqual(other)", true
+	}
+	for _, name := range []string{"DeepEqual", "Equal"} {
+		sig, err := equalMethod(t, name)
+		if err != nil {
+			if name == "DeepEqual" {
+				klog.Fatal(err)
+			}
+			continue
+		}
+		if sig == nil {
+			continue
+		}
+		if sig.Parameters[0].Type.Kind == types.Pointer {
+			return fmt.Sprintf("in.%s(other)", name), true
+		}
+		return fmt.Sprintf("in.%s(*other)", name), true
+	}
+	return "", false
+}
+
+// generateDeepEqual writes the DeepEqual method of t.
+func (g *genDeepCopy) generateDeepEqual(c *generator.Context, t *types.Type, sw *generator.SnippetWriter) {
+	args := argsFromType(t)
+	sw.Do("// DeepEqual is an autogenerated function, reporting whether the receiver and other are deeply\n", nil)
+	sw.Do("// equal without using reflection. Nil and empty slices and maps are equal.\n", nil)
+	if isReference(t) {
+		sw.Do("func (in $.type|raw$) DeepEqual(other $.type|raw$) bool {\n", args)
+		sw.Do("{in, other := &in, &other\n", nil)
+		g.equalInline(c, t, sw)
+		sw.Do("}\n", nil)
+	} else {
+		sw.Do("func (in *$.type|raw$) DeepEqual(other *$.type|raw$) bool {\n", args)
+		sw.Do("if in == other { return true }\n", nil)
+		sw.Do("if in == nil || other == nil { return false }\n", nil)
+		g.equalInline(c, t, sw)
+	}
+	sw.Do("return true\n", nil)
+	sw.Do("}\n\n", nil)
+}
+
+// equalFor writes code returning false if the values of type t in and other
+// point to differ. Like generateFor, it relies on shadowing in and other.
+func (g *genDeepCopy) equalFor(c *generator.Context, t *types.Type, sw *generator.SnippetWriter) {
+	if call, ok := equalCall(c, t); ok {
+		sw.Do("if !"+call+" { return false }\n", nil)
+		return
+	}
+	g.equalInline(c, t, sw)
+}
+
+// equalInline writes the comparison of values of type t member by member,
+// element by element, without using a method of t.
+func (g *genDeepCopy) equalInline(c *generator.Context, t *types.Type, sw *generator.SnippetWriter) {
+	if g.inlining[t] {
+		klog.Fatalf("Type %v is recursive and has no DeepEqual method, generate one with +%s=true,deep-equal", t, tagEnabledName)
+	}
+	g.inlining[t] = true
+	defer delete(g.inlining, t)
+
+	ut := underlyingType(t)
+	switch ut.Kind {
+	case types.Builtin:
+		sw.Do("if *in != *other { return false }\n", nil)
+	case types.Map:
+		sw.Do("if len(*in) != len(*other) { return false }\n", nil)
+		sw.Do("for key, val := range *in {\n", nil)
+		sw.Do("otherVal, ok := (*other)[key]\n", nil)
+		sw.Do("if !ok { return false }\n", nil)
+		if g.isEqualityComparable(c, ut.Elem) {
+			sw.Do("if val != otherVal { return false }\n", nil)
+		} else {
+			sw.Do("in, other := &val, &otherVal\n", nil)
+			g.equalFor(c, ut.Elem, sw)
+		}
+		sw.Do("}\n", nil)
+	case types.Slice, types.Array:
+		if ut.Kind == types.Slice {
+			sw.Do("if len(*in) != len(*other) { return false }\n", nil)
+		}
+		sw.Do("for i := range *in {\n", nil)
+		if g.isEqualityComparable(c, ut.Elem) {
+			sw.Do("if (*in)[i] != (*other)[i] { return false }\n", nil)
+		} else {
+			sw.Do("in, other := &(*in)[i], &(*other)[i]\n", nil)
+			g.equalFor(c, ut.Elem, sw)
+		}
+		sw.Do("}\n", nil)
+	case types.Pointer:
+		sw.Do("if (*in == nil) != (*other == nil) { return false }\n", nil)
+		if g.isEqualityComparable(c, ut.Elem) {
+			sw.Do("if *in != nil && **in != **other { return false }\n", nil)
+		} else {
+			sw.Do("if *in != nil {\n", nil)
+			sw.Do("in, other := *in, *other\n", nil)
+			g.equalFor(c, ut.Elem, sw)
+			sw.Do("}\n", nil)
+		}
+	case types.Struct:
+		// Instantiations of generic types have no members, see genericType.
+		if len(ut.TypeParams) > 0 && len(ut.Members) == 0 {
+			klog.Fatalf("DeepEqual of %v is unsupported: it is an instantiation of a generic type without a generated DeepEqual method", t)
+		}
+		g.equalStruct(c, t, sw)
+	case types.TypeParam:
+		klog.Fatalf("DeepEqual of type parameter %v is unsupported unless constrained to builtin types", t)
+	case types.Interface:
+		klog.Fatalf("DeepEqual of interface type %v is unsupported.", t)
+	default:
+		klog.Fatalf("Hit an unsupported type %v.", t)
+	}
+}
+
+// equalStruct writes the comparison of the members of a struct, or an alias
+// to a struct.
+func (g *genDeepCopy) equalStruct(c *generator.Context, t *types.Type, sw *generator.SnippetWriter) {
+	for _, m := range g.members(c, t) {
+		if isSkippedField(m) {
+			continue
+		}
+		if t.Name.Package != g.targetPackage && namer.IsPrivateGoName(m.Name) {
+			klog.Fatalf("DeepEqual of %v is unsupported: it has no DeepEqual or Equal method, and its field %s is private", t, m.Name)
+		}
+		args := generator.Args{
+			"name": m.Name,
+		}
+		if g.isEqualityComparable(c, m.Type) {
+			sw.Do("if in.$.name$ != other.$.name$ { return false }\n", args)
+			continue
+		}
+		sw.Do("{\n", nil)
+		sw.Do("in, other := &in.$.name$, &other.$.name$\n", args)
+		g.equalFor(c, m.Type, sw)
+		sw.Do("}\n", nil)
+	}
+}
+
+// isEqualityComparable returns whether the values of type t can be compared
+// with ==, which is the case for builtins without equality methods.
+func (g *genDeepCopy) isEqualityComparable(c *generator.Context, t *types.Type) bool {
+	if _, ok := equalCall(c, t); ok {
+		return false
+	}
+	if t.Kind == types.TypeParam {
+		return !g.typeParamCopies[t]
+	}
+	return underlyingType(t).Kind == types.Builtin
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	gotypes "go/types"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// gengo names the instantiations of a generic type like the generic type
+// itself, e.g. both List[T] and List[string] are named List[T], and records
+// the members of whichever it walked first. The members of structs using
+// generic types are therefore taken from go/types instead.
+
+// usesGenerics returns whether t is a type parameter, an instantiation of a
+// generic type, or is composed of those.
+func usesGenerics(t *types.Type) bool {
+	switch t.Kind {
+	case types.TypeParam:
+		return true
+	case types.Struct, types.Interface:
+		return len(t.TypeParams) > 0
+	case types.Pointer, types.Slice, types.Array:
+		return usesGenerics(t.Elem)
+	case types.Map:
+		return usesGenerics(t.Key) || usesGenerics(t.Elem)
+	}
+	return false
+}
+
+// members returns the members of t, a struct or an alias to a struct, with
+// the types of the members using generics as declared.
+func (g *genDeepCopy) members(c *generator.Context, t *types.Type) []types.Member {
+	ut := underlyingType(t)
+	generic := usesGenerics(ut)
+	for i := 0; !generic && i < len(ut.Members); i++ {
+		generic = usesGenerics(ut.Members[i].Type)
+	}
+	if !generic {
+		return ut.Members
+	}
+
+	st := g.goStruct(t)
+	if st.NumFields() != len(ut.Members) {
+		klog.Fatalf("Type %v has %d fields, but %d members", t, st.NumFields(), len(ut.Members))
+	}
+	members := make([]types.Member, len(ut.Members))
+	for i, m := range ut.Members {
+		if usesGenerics(m.Type) || usesGenerics(ut) {
+			m.Type = g.genericType(c, t, st.Field(i).Type())
+		}
+		members[i] = m
+	}
+	return members
+}
+
+// goStruct returns the go/types struct of the named type t.
+func (g *genDeepCopy) goStruct(t *types.Type) *gotypes.Struct {
+	pkg, ok := g.goPackages[t.Name.Package]
+	if !ok {
+		klog.V(3).Infof("Loading types of package %s for generic types", t.Name.Package)
+		// Like gengo, load from source, ignoring generated files.
+		cfg := &packages.Config{
+			Mode:       packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedImports | packages.NeedDeps,
+			BuildFlags: []string{"-tags", gengo.StdBuildTag},
+		}
+		pkgs, err := packages.Load(cfg, t.Name.Package)
+		if err != nil {
+			klog.Fatalf("Failed loading package %s: %v", t.Name.Package, err)
+		}
+		if len(pkgs) != 1 {
+			klog.Fatalf("Failed loading package %s", t.Name.Package)
+		}
+		// Type errors are expected, e.g. the methods being generated are
+		// missing.
+		for _, err := range pkgs[0].Errors {
+			if err.Kind != packages.TypeError {
+				klog.Fatalf("Failed loading package %s: %v", t.Name.Package, err)
+			}
+		}
+		pkg = pkgs[0].Types
+		g.goPackages[t.Name.Package] = pkg
+	}
+	obj := pkg.Scope().Lookup(strings.SplitN(t.Name.Name, "[", 2)[0])
+	if obj == nil {
+		klog.Fatalf("Type %v not found in package %s", t, t.Name.Package)
+	}
+	st, ok := obj.Type().Underlying().(*gotypes.Struct)
+	if !ok {
+		klog.Fatalf("Type %v is not a struct", t)
+	}
+	return st
+}
+
+// genericType returns the gengo type of a member of the struct t using
+// generics. Instantiations of generic types are named with their type
+// arguments and have no members: they are always copied with DeepCopyInto.
+func (g *genDeepCopy) genericType(c *generator.Context, t *types.Type, in gotypes.Type) *types.Type {
+	switch gt := in.(type) {
+	case *gotypes.TypeParam:
+		// Type parameters are named in the package of t, so that the raw
+		// namer writes their bare name.
+		out := &types.Type{
+			Name: types.Name{Package: t.Name.Package, Name: gt.Obj().Name()},
+			Kind: types.TypeParam,
+		}
+		copies, ok := typeParamCopies(gt)
+		if !ok {
+			klog.Fatalf("Type %v: type parameter %s must be constrained to builtin types, or to types with a DeepCopy() %s method", t, gt.Obj().Name(), gt.Obj().Name())
+		}
+		g.typeParamCopies[out] = copies
+		return out
+	case *gotypes.Pointer:
+		return &types.Type{Name: types.Name{Name: in.String()}, Kind: types.Pointer, Elem: g.genericType(c, t, gt.Elem())}
+	case *gotypes.Slice:
+		return &types.Type{Name: types.Name{Name: in.String()}, Kind: types.Slice, Elem: g.genericType(c, t, gt.Elem())}
+	case *gotypes.Array:
+		return &types.Type{Name: types.Name{Name: in.String()}, Kind: types.Array, Len: gt.Len(), Elem: g.genericType(c, t, gt.Elem())}
+	case *gotypes.Map:
+		return &types.Type{Name: types.Name{Name: in.String()}, Kind: types.Map, Key: g.genericType(c, t, gt.Key()), Elem: g.genericType(c, t, gt.Elem())}
+	case *gotypes.Basic:
+		return c.Universe.Type(types.Name{Name: gt.Name()})
+	case *gotypes.Named:
+		name := types.Name{Name: gt.Obj().Name()}
+		if gt.Obj().Pkg() != nil {
+			name.Package = gt.Obj().Pkg().Path()
+		}
+		if gt.TypeArgs().Len() == 0 {
+			return c.Universe.Type(name)
+		}
+		var params, args []string
+		for i := 0; i < gt.TypeArgs().Len(); i++ {
+			params = append(params, gt.Origin().TypeParams().At(i).Obj().Name())
+			args = append(args, c.Namers["raw"].Name(g.genericType(c, t, gt.TypeArgs().At(i))))
+		}
+		origin := c.Universe.Type(types.Name{Package: name.Package, Name: name.Name + "[" + strings.Join(params, ",") + "]"})
+		out := *origin
+		out.Name.Name = name.Name + "[" + strings.Join(args, ",") + "]"
+		out.Members = nil
+		out.Methods = nil
+		return &out
+	default:
+		klog.Fatalf("Type %v: unsupported member type %v", t, in)
+		return nil
+	}
+}
+
+// typeParamCopies returns whether the values of type parameter tp are copied
+// with a DeepCopy method of its constraint, or by assignment, and whether tp
+// can be copied at all.
+func typeParamCopies(tp *gotypes.TypeParam) (bool, bool) {
+	iface, ok := tp.Constraint().Underlying().(*gotypes.Interface)
+	if !ok {
+		return false, false
+	}
+	for i := 0; i < iface.NumMethods(); i++ {
+		m := iface.Method(i)
+		sig := m.Type().(*gotypes.Signature)
+		if m.Name() == "DeepCopy" && sig.Params().Len() == 0 && sig.Results().Len() == 1 && gotypes.Identical(sig.Results().At(0).Type(), tp) {
+			return true, true
+		}
+	}
+	if iface.NumEmbeddeds() == 0 {
+		return false, false
+	}
+	for i := 0; i < iface.NumEmbeddeds(); i++ {
+		switch e := iface.EmbeddedType(i).(type) {
+		case *gotypes.Union:
+			for j := 0; j < e.Len(); j++ {
+				if _, ok := e.Term(j).Type().Underlying().(*gotypes.Basic); !ok {
+					return false, false
+				}
+			}
+		default:
+			if _, ok := e.Underlying().(*gotypes.Basic); !ok {
+				return false, false
+			}
+		}
+	}
+	return false, true
+}
+
+// isAssignable is like IsAssignable, but instantiations of generic types,
+// structs with skipped fields and types with copy functions are never
+// deep-assignable, shallow fields always are, and type parameters are if their
+// constraint only allows builtin types.
+func (g *genDeepCopy) isAssignable(t *types.Type) bool {
+	if _, ok := g.copyFunc(t); ok {
+		return false
+	}
+	switch {
+	case t.IsPrimitive():
+		return true
+	case t.Kind == types.TypeParam:
+		return !g.typeParamCopies[t]
+	case t.Kind == types.Struct:
+		if len(t.TypeParams) > 0 || hasSkippedFields(t) {
+			return false
+		}
+		for _, m := range t.Members {
+			if !isShallowField(m) && !g.isAssignable(m.Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"strings"
+
+	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// extractImplementationsTag returns the implementations listed in the
+// implementations tags of a struct member.
+func extractImplementationsTag(m types.Member) []string {
+	var result []string
+	values := gengo.ExtractCommentTags("+", m.CommentLines)[implementationsTagName]
+	for _, v := range values {
+		for _, impl := range strings.Split(v, ",") {
+			if impl == "" {
+				continue
+			}
+			result = append(result, impl)
+		}
+	}
+	return result
+}
+
+// memberImplementations returns the types listed in the implementations tag
+// of the member m of t, or nil if there is no tag.
+func (g *genDeepCopy) memberImplementations(t *types.Type, m types.Member) []*types.Type {
+	names := extractImplementationsTag(m)
+	if len(names) == 0 {
+		return nil
+	}
+	if !hasInterfaceElem(m.Type) {
+		klog.Fatalf("Type %v: %s tag on member %s, which holds no interface", t, implementationsTagName, m.Name)
+	}
+	var impls []*types.Type
+	for _, name := range names {
+		pointer := strings.HasPrefix(name, "*")
+		n := types.ParseFullyQualifiedName(strings.TrimPrefix(name, "*"))
+		if n.Package != "" {
+			klog.V(3).Infof("Loading package for implementation %v", name)
+			if _, err := g.context.LoadPackages(n.Package); err != nil {
+				klog.Fatalf("Type %v: failed loading package of implementation %q: %v", t, name, err)
+			}
+		}
+		impl := g.context.Universe.Type(n)
+		if impl.Kind == types.Unknown {
+			klog.Fatalf("Type %v: unknown type %q in %s tag of member %s", t, name, implementationsTagName, m.Name)
+		}
+		if impl.Kind == types.Interface {
+			klog.Fatalf("Type %v: type %q in %s tag of member %s is an interface", t, name, implementationsTagName, m.Name)
+		}
+		if pointer {
+			impl = &types.Type{Name: types.Name{Name: "*" + impl.String()}, Kind: types.Pointer, Elem: impl}
+		}
+		g.imports.AddType(impl)
+		impls = append(impls, impl)
+	}
+	return impls
+}
+
+// hasInterfaceElem returns whether t is an interface, or a slice or map of
+// interfaces.
+func hasInterfaceElem(t *types.Type) bool {
+	ut := underlyingType(t)
+	if ut.Kind == types.Slice || ut.Kind == types.Map {
+		ut = underlyingType(ut.Elem)
+	}
+	return ut.Kind == types.Interface
+}
+
+// doImplementations generates a type switch deep-copying the non-nil value of
+// interface type in into out, for each of the implementations listed in the
+// implementations tag of member. Other implementations panic.
+func (g *genDeepCopy) doImplementations(member string, impls []*types.Type, in, out string, sw *generator.SnippetWriter) {
+	args := generator.Args{
+		"in":      in,
+		"out":     out,
+		"member":  member,
+		"Sprintf": types.Ref("fmt", "Sprintf"),
+	}
+	sw.Do("switch v := $.in$.(type) {\n", args)
+	for _, impl := range impls {
+		args := args.With("impl", impl)
+		sw.Do("case $.impl|raw$:\n", args)
+		switch {
+		case impl.Kind == types.Pointer && g.isAssignable(impl.Elem):
+			sw.Do("x := *v\n", nil)
+			sw.Do("$.out$ = &x\n", args)
+		case impl.Kind == types.Pointer, isReference(impl):
+			sw.Do("$.out$ = v.DeepCopy()\n", args)
+		case g.isAssignable(impl):
+			sw.Do("$.out$ = v\n", args)
+		default:
+			sw.Do("$.out$ = *v.DeepCopy()\n", args)
+		}
+	}
+	sw.Do("default:\n", nil)
+	sw.Do("panic($.Sprintf|raw$(\"unexpected type %T in $.member$, not listed in +"+implementationsTagName+"\", v))\n", args)
+	sw.Do("}\n", nil)
+}
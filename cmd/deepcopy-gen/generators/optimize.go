@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"k8s.io/gengo/v2/types"
+)
+
+// isPlainOldData returns whether the values of t hold no references and have
+// no deepcopy methods or copy functions of their own, so that they are
+// deep-copied by assignment. Unlike isAssignable, arrays and structs with
+// array members can be plain old data.
+func (g *genDeepCopy) isPlainOldData(t *types.Type) bool {
+	if deepCopyMethodOrDie(t) != nil || deepCopyIntoMethodOrDie(t) != nil {
+		return false
+	}
+	if _, ok := g.copyFunc(t); ok {
+		return false
+	}
+	switch ut := underlyingType(t); ut.Kind {
+	case types.Builtin:
+		return true
+	case types.TypeParam:
+		return !g.typeParamCopies[ut]
+	case types.Array:
+		return g.isPlainOldData(ut.Elem)
+	case types.Struct:
+		if len(ut.TypeParams) > 0 || hasSkippedFields(ut) {
+			return false
+		}
+		for _, m := range ut.Members {
+			if !g.isPlainOldData(m.Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// copiedByAssignment returns whether the values of t are deep-copied by
+// assignment, which in the optimized mode covers all plain old data.
+func (g *genDeepCopy) copiedByAssignment(t *types.Type) bool {
+	if g.optimize {
+		return g.isPlainOldData(t)
+	}
+	return g.isAssignable(t)
+}
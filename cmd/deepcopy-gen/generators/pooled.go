@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"strings"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// needsPooled returns whether pooled deepcopy functions are generated for t,
+// which is the case if t is tagged with pooled.
+func needsPooled(t *types.Type) bool {
+	if !extractBoolTypeTag(t, pooledTagName) {
+		return false
+	}
+	if t.Kind != types.Struct || len(t.TypeParams) > 0 {
+		klog.Fatalf("Type %v: %s is only supported on non-generic structs", t, pooledTagName)
+	}
+	if deepCopyMethodOrDie(t) != nil || deepCopyIntoMethodOrDie(t) != nil {
+		klog.Fatalf("Type %v: %s is not supported on types with a DeepCopy method", t, pooledTagName)
+	}
+	return true
+}
+
+// hasPooledCopy returns whether t has a generated DeepCopyIntoPooled method.
+func hasPooledCopy(t *types.Type) bool {
+	return t.Kind == types.Struct && len(t.TypeParams) == 0 && extractBoolTypeTag(t, pooledTagName)
+}
+
+// generatePooled writes the pool of t and the DeepCopyPooled,
+// DeepCopyIntoPooled and ReleasePooled methods using it.
+func (g *genDeepCopy) generatePooled(t *types.Type, sw *generator.SnippetWriter) {
+	args := argsFromType(t).
+		With("pool", strings.ToLower(t.Name.Name[:1])+t.Name.Name[1:]+"Pool").
+		With("Pool", types.Ref("sync", "Pool"))
+	sw.Do("// $.pool$ holds the released copies of $.type|raw$, with their allocations.\n", args)
+	sw.Do("var $.pool$ = $.Pool|raw${New: func() interface{} { return new($.type|raw$) }}\n\n", args)
+
+	sw.Do("// DeepCopyPooled is an autogenerated deepcopy function, copying the receiver into a copy of\n", nil)
+	sw.Do("// $.type|raw$ taken from a pool, reusing the allocations of the copies released to it.\n", args)
+	sw.Do("func (in *$.type|raw$) DeepCopyPooled() *$.type|raw$ {\n", args)
+	sw.Do("if in == nil { return nil }\n", nil)
+	sw.Do("out := $.pool$.Get().(*$.type|raw$)\n", args)
+	sw.Do("in.DeepCopyIntoPooled(out)\n", nil)
+	sw.Do("return out\n", nil)
+	sw.Do("}\n\n", nil)
+
+	sw.Do("// ReleasePooled is an autogenerated function, releasing the receiver to the pool of\n", nil)
+	sw.Do("// DeepCopyPooled. Neither the receiver nor any object it references may be used anymore.\n", nil)
+	sw.Do("func (in *$.type|raw$) ReleasePooled() {\n", args)
+	sw.Do("$.pool$.Put(in)\n", args)
+	sw.Do("}\n\n", nil)
+
+	sw.Do("// DeepCopyIntoPooled is an autogenerated deepcopy function, copying the receiver, writing into\n", nil)
+	sw.Do("// out like DeepCopyInto, but reusing the slices, maps and structs out references, which must\n", nil)
+	sw.Do("// not be in use anymore. in must be non-nil.\n", nil)
+	sw.Do("func (in *$.type|raw$) DeepCopyIntoPooled(out *$.type|raw$) {\n", args)
+	for _, m := range g.members(g.context, t) {
+		switch {
+		case isSkippedField(m):
+			g.zeroField(m, sw)
+		case isShallowField(m):
+			sw.Do("out.$.name$ = in.$.name$\n", generator.Args{"name": m.Name})
+		default:
+			g.doPooledMember(t, m, sw)
+		}
+	}
+	sw.Do("}\n\n", nil)
+}
+
+// doPooledMember generates the copy of the member m of the struct t, reusing
+// the allocations of out where possible.
+func (g *genDeepCopy) doPooledMember(t *types.Type, m types.Member, sw *generator.SnippetWriter) {
+	ft := m.Type
+	uft := underlyingType(ft)
+	args := generator.Args{
+		"type": ft,
+		"name": m.Name,
+	}
+	_, cf := g.copyFunc(ft)
+	custom := cf || deepCopyMethodOrDie(ft) != nil || deepCopyIntoMethodOrDie(ft) != nil
+	switch {
+	case !custom && g.copiedByAssignment(ft):
+		sw.Do("out.$.name$ = in.$.name$\n", args)
+	case !custom && uft.Kind == types.Slice && g.reusesElems(uft.Elem):
+		sw.Do("if in.$.name$ == nil {\n", args)
+		sw.Do("out.$.name$ = nil\n", args)
+		sw.Do("} else {\n", nil)
+		sw.Do("in, out := &in.$.name$, &out.$.name$\n", args)
+		sw.Do("if *out == nil || cap(*out) < len(*in) {\n", nil)
+		sw.Do("*out = make($.type|raw$, len(*in))\n", args)
+		sw.Do("} else {\n", nil)
+		sw.Do("*out = (*out)[:len(*in)]\n", nil)
+		sw.Do("}\n", nil)
+		if g.copiedByAssignment(uft.Elem) {
+			sw.Do("copy(*out, *in)\n", nil)
+		} else {
+			sw.Do("for i := range *in {\n", nil)
+			g.doPooledStruct(uft.Elem, "(*in)[i]", "&(*out)[i]", sw)
+			sw.Do("}\n", nil)
+		}
+		sw.Do("}\n", nil)
+	case !custom && uft.Kind == types.Map && g.copiedByAssignment(uft.Key) && g.copiedByAssignment(uft.Elem):
+		sw.Do("if in.$.name$ == nil {\n", args)
+		sw.Do("out.$.name$ = nil\n", args)
+		sw.Do("} else {\n", nil)
+		sw.Do("in, out := &in.$.name$, &out.$.name$\n", args)
+		sw.Do("if *out == nil {\n", nil)
+		sw.Do("*out = make($.type|raw$, len(*in))\n", args)
+		sw.Do("} else {\n", nil)
+		sw.Do("clear(*out)\n", nil)
+		sw.Do("}\n", nil)
+		sw.Do("for key, val := range *in {\n", nil)
+		sw.Do("(*out)[key] = val\n", nil)
+		sw.Do("}\n", nil)
+		sw.Do("}\n", nil)
+	case !custom && uft.Kind == types.Pointer && g.reusesElems(uft.Elem):
+		sw.Do("if in.$.name$ == nil {\n", args)
+		sw.Do("out.$.name$ = nil\n", args)
+		sw.Do("} else {\n", nil)
+		sw.Do("if out.$.name$ == nil {\n", args)
+		sw.Do("out.$.name$ = new($.type.Elem|raw$)\n", args)
+		sw.Do("}\n", nil)
+		if g.copiedByAssignment(uft.Elem) {
+			sw.Do("*out.$.name$ = *in.$.name$\n", args)
+		} else {
+			g.doPooledStruct(uft.Elem, "in."+m.Name, "out."+m.Name, sw)
+		}
+		sw.Do("}\n", nil)
+	case !custom && g.reusesElems(ft):
+		g.doPooledStruct(ft, "in."+m.Name, "&out."+m.Name, sw)
+	default:
+		// Copy like DeepCopyInto, without reusing allocations.
+		if !hasSkippedFields(ft) {
+			sw.Do("out.$.name$ = in.$.name$\n", args)
+		}
+		g.doMember(t, m, sw)
+	}
+}
+
+// reusesElems returns whether the values of t can be copied into existing
+// values reusing their allocations, which is the case for values copied by
+// assignment and for structs with a DeepCopyInto method.
+func (g *genDeepCopy) reusesElems(t *types.Type) bool {
+	if g.copiedByAssignment(t) {
+		return true
+	}
+	return t.Kind == types.Struct && len(t.TypeParams) == 0 && (copyableType(t) || deepCopyIntoMethodOrDie(t) != nil)
+}
+
+// doPooledStruct generates the copy of the struct in into the struct out
+// points to, with DeepCopyIntoPooled if it is generated, and DeepCopyInto
+// otherwise.
+func (g *genDeepCopy) doPooledStruct(t *types.Type, in, out string, sw *generator.SnippetWriter) {
+	args := generator.Args{
+		"in":  in,
+		"out": out,
+	}
+	if hasPooledCopy(t) {
+		sw.Do("$.in$.DeepCopyIntoPooled($.out$)\n", args)
+		return
+	}
+	sw.Do("$.in$.DeepCopyInto($.out$)\n", args)
+}
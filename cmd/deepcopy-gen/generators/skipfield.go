@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// isSkippedField returns whether the member m is tagged to be skipped, i.e.
+// zeroed in copies and ignored in comparisons.
+func isSkippedField(m types.Member) bool {
+	values := gengo.ExtractCommentTags("+", m.CommentLines)[skipFieldTagName]
+	if len(values) == 0 {
+		return false
+	}
+	if len(values) > 1 || (values[0] != "" && values[0] != "true" && values[0] != "false") {
+		klog.Fatalf("Member %s: unsupported %s value: %q", m.Name, skipFieldTagName, values)
+	}
+	return values[0] != "false"
+}
+
+// isShallowField returns whether the member m is tagged to be copied by
+// assignment, sharing what it references with the original, e.g. large byte
+// slices which are never mutated.
+func isShallowField(m types.Member) bool {
+	values := gengo.ExtractCommentTags("+", m.CommentLines)[shallowTagName]
+	if len(values) == 0 {
+		return false
+	}
+	if len(values) > 1 || (values[0] != "" && values[0] != "true" && values[0] != "false") {
+		klog.Fatalf("Member %s: unsupported %s value: %q", m.Name, shallowTagName, values)
+	}
+	if values[0] != "false" && isSkippedField(m) {
+		klog.Fatalf("Member %s: %s and %s are mutually exclusive", m.Name, shallowTagName, skipFieldTagName)
+	}
+	return values[0] != "false"
+}
+
+// hasSkippedFields returns whether t is a struct with skipped fields, directly
+// or in the structs it embeds by value. Such structs are copied member by
+// member, so that the skipped fields, e.g. mutexes, are never copied.
+func hasSkippedFields(t *types.Type) bool {
+	ut := underlyingType(t)
+	if ut.Kind != types.Struct {
+		return false
+	}
+	for _, m := range ut.Members {
+		if isSkippedField(m) || hasSkippedFields(m.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyMembers writes the copy of the struct in points to into out, by
+// assignment, or member by member with the skipped fields zeroed if there are
+// any. Members which are not copied by assignment must be fixed up after,
+// except shallow ones.
+func (g *genDeepCopy) copyMembers(t *types.Type, sw *generator.SnippetWriter) {
+	if !hasSkippedFields(t) {
+		sw.Do("*out = *in\n", nil)
+		return
+	}
+	for _, m := range g.members(g.context, t) {
+		args := generator.Args{
+			"type": m.Type,
+			"name": m.Name,
+		}
+		switch {
+		case isSkippedField(m):
+			g.zeroField(m, sw)
+		case isShallowField(m):
+			sw.Do("out.$.name$ = in.$.name$\n", args)
+		case hasSkippedFields(m.Type):
+			// copied member by member by its DeepCopyInto
+		default:
+			sw.Do("out.$.name$ = in.$.name$\n", args)
+		}
+	}
+}
+
+// zeroField writes the assignment of the zero value to the member m of out.
+func (g *genDeepCopy) zeroField(m types.Member, sw *generator.SnippetWriter) {
+	args := generator.Args{
+		"type": m.Type,
+		"name": m.Name,
+	}
+	switch ut := underlyingType(m.Type); ut.Kind {
+	case types.Pointer, types.Map, types.Slice, types.Chan, types.Func, types.Interface:
+		sw.Do("out.$.name$ = nil\n", args)
+	case types.Struct, types.Array:
+		sw.Do("out.$.name$ = $.type|raw${}\n", args)
+	case types.Builtin:
+		switch ut {
+		case types.String:
+			sw.Do("out.$.name$ = \"\"\n", args)
+		case types.Bool:
+			sw.Do("out.$.name$ = false\n", args)
+		default:
+			sw.Do("out.$.name$ = 0\n", args)
+		}
+	default:
+		sw.Do("out.$.name$ = *new($.type|raw$)\n", args)
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+// deepCopyCheckSource is the source of the helpers of the generated tests,
+// which are written after the tests, without the package clause and imports.
+//
+//go:embed deepcopycheck_test.go
+var deepCopyCheckSource string
+
+// deepCopyCheckImports are the packages the helpers of the generated tests
+// import.
+var deepCopyCheckImports = []string{"fmt", "reflect", "testing", "time"}
+
+// deepCopyCheckFuncs returns the declarations of deepCopyCheckSource.
+func deepCopyCheckFuncs() string {
+	const importsEnd = "\n)\n"
+	return deepCopyCheckSource[strings.Index(deepCopyCheckSource, importsEnd)+len(importsEnd):]
+}
+
+// deepCopyTestsFileType is the file type of the generated tests, which are
+// not written for packages without types to test.
+const deepCopyTestsFileType = "deepcopytests"
+
+// deepCopyTestsFile assembles Go files, skipping those without a body.
+type deepCopyTestsFile struct {
+	generator.FileType
+}
+
+func (ft deepCopyTestsFile) AssembleFile(f *generator.File, path string) error {
+	if f.Body.Len() == 0 {
+		klog.V(2).Infof("Not writing %s, which has no tests", path)
+		return nil
+	}
+	return ft.FileType.AssembleFile(f, path)
+}
+
+// genDeepCopyTests produces a test file which checks that the DeepCopy
+// methods of the types of a package return copies which are semantically equal
+// to, but share no pointers with, fuzzed originals, and benchmarks them.
+type genDeepCopyTests struct {
+	generator.GoGenerator
+	targetPackage string
+	deepCopy      *genDeepCopy
+	imports       namer.ImportTracker
+}
+
+// NewGenDeepCopyTests returns a generator which writes tests and benchmarks
+// for the types deepCopy generated deepcopy functions for. It must run after
+// deepCopy in the same target.
+func NewGenDeepCopyTests(outputFilename, targetPackage string, deepCopy *genDeepCopy) generator.Generator {
+	return &genDeepCopyTests{
+		GoGenerator: generator.GoGenerator{
+			OutputFilename: outputFilename,
+		},
+		targetPackage: targetPackage,
+		deepCopy:      deepCopy,
+		imports:       generator.NewImportTrackerForPackage(targetPackage),
+	}
+}
+
+func (g *genDeepCopyTests) FileType() string { return deepCopyTestsFileType }
+
+func (g *genDeepCopyTests) Namers(c *generator.Context) namer.NameSystems {
+	return namer.NameSystems{
+		"raw": namer.NewRawNamer(g.targetPackage, g.imports),
+	}
+}
+
+func (g *genDeepCopyTests) Filter(c *generator.Context, t *types.Type) bool {
+	return false
+}
+
+func (g *genDeepCopyTests) Imports(c *generator.Context) (imports []string) {
+	var importLines []string
+	for _, singleImport := range g.imports.ImportLines() {
+		if g.deepCopy.isOtherPackage(singleImport) {
+			importLines = append(importLines, singleImport)
+		}
+	}
+	return importLines
+}
+
+func (g *genDeepCopyTests) Init(c *generator.Context, w io.Writer) error {
+	var tested []*types.Type
+	for _, t := range g.deepCopy.generatedTypes {
+		if !fuzzable(t, map[*types.Type]bool{}) {
+			klog.V(2).Infof("Not generating deepcopy tests for type %v, which cannot be fuzzed", t)
+			continue
+		}
+		tested = append(tested, t)
+	}
+	if len(tested) == 0 {
+		return nil
+	}
+
+	// The helpers refer to the packages they import by their names, so these
+	// are tracked before any other package which could take them.
+	for _, pkg := range deepCopyCheckImports {
+		g.imports.AddSymbol(types.Name{Package: pkg})
+		if name := g.imports.LocalNameOf(pkg); name != pkg {
+			return fmt.Errorf("package %s is imported as %s", pkg, name)
+		}
+	}
+
+	sw := generator.NewSnippetWriter(w, c, "$", "$")
+	args := generator.Args{
+		"T":         types.Ref("testing", "T"),
+		"B":         types.Ref("testing", "B"),
+		"New":       types.Ref("github.com/google/gofuzz", "New"),
+		"NewSeeded": types.Ref("github.com/google/gofuzz", "NewWithSeed"),
+		// The objects are fuzzed with bounded sizes and depth.
+		"fuzzerOptions": ".NilChance(0.2).NumElements(1, 3).MaxDepth(10)",
+	}
+	for _, t := range tested {
+		args := args.With("type", t).With("name", t.Name.Name)
+		// DeepCopy returns a pointer unless defined otherwise, or generated
+		// for a reference type.
+		returnsPointer := !isReference(t)
+		if dc := deepCopyMethodOrDie(t); dc != nil {
+			returnsPointer = dc.Results[0].Type.Kind == types.Pointer
+		}
+
+		sw.Do("func TestDeepCopy_$.name$(t *$.T|raw$) {\n", args)
+		sw.Do("f := $.New|raw$()$.fuzzerOptions$\n", args)
+		sw.Do("for i := 0; i < 100 && !t.Failed(); i++ {\n", nil)
+		sw.Do("in := new($.type|raw$)\n", args)
+		sw.Do("f.Fuzz(in)\n", nil)
+		if returnsPointer {
+			sw.Do("out := (*in).DeepCopy()\n", nil)
+		} else {
+			sw.Do("out := new($.type|raw$)\n", args)
+			sw.Do("*out = (*in).DeepCopy()\n", nil)
+		}
+		sw.Do("checkDeepCopy(t, \"$.name$\", in, out)\n", args)
+		sw.Do("}\n", nil)
+		sw.Do("}\n\n", nil)
+
+		sw.Do("func BenchmarkDeepCopy_$.name$(b *$.B|raw$) {\n", args)
+		sw.Do("in := new($.type|raw$)\n", args)
+		sw.Do("$.NewSeeded|raw$(1)$.fuzzerOptions$.Fuzz(in)\n", args)
+		sw.Do("b.ReportAllocs()\n", nil)
+		sw.Do("b.ResetTimer()\n", nil)
+		sw.Do("for i := 0; i < b.N; i++ {\n", nil)
+		sw.Do("_ = (*in).DeepCopy()\n", nil)
+		sw.Do("}\n", nil)
+		sw.Do("}\n\n", nil)
+	}
+	if err := sw.Error(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, deepCopyCheckFuncs())
+	return err
+}
+
+// fuzzable returns whether the values of t can be fuzzed, and compared to
+// their copies after fuzzing: only the exported fields are fuzzed, and they
+// must not hold interfaces, channels, funcs or type parameters, nor be
+// skipped or shared by the copies.
+func fuzzable(t *types.Type, visiting map[*types.Type]bool) bool {
+	if visiting[t] {
+		return true
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	switch t.Kind {
+	case types.Builtin:
+		return true
+	case types.Alias:
+		return fuzzable(t.Underlying, visiting)
+	case types.Pointer, types.Slice, types.Array:
+		return fuzzable(t.Elem, visiting)
+	case types.Map:
+		return fuzzable(t.Key, visiting) && fuzzable(t.Elem, visiting)
+	case types.Struct:
+		if len(t.TypeParams) > 0 {
+			return false
+		}
+		for _, m := range t.Members {
+			if namer.IsPrivateGoName(m.Name) && !m.Embedded {
+				continue
+			}
+			if isSkippedField(m) || isShallowField(m) || !fuzzable(m.Type, visiting) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// recorder records the failures reported by checkDeepCopy.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+type inner struct {
+	I int
+}
+
+type object struct {
+	S   string
+	P   *inner
+	L   []inner
+	M   map[string]string
+	Any interface{}
+}
+
+func Test_checkDeepCopy(t *testing.T) {
+	shared := &inner{I: 1}
+	tests := []struct {
+		name    string
+		in, out object
+		errors  []string
+	}{{
+		name: "copy",
+		in:   object{S: "a", P: &inner{I: 1}, L: []inner{{I: 2}}, M: map[string]string{"k": "v"}, Any: 1},
+		out:  object{S: "a", P: &inner{I: 1}, L: []inner{{I: 2}}, M: map[string]string{"k": "v"}, Any: 1},
+	}, {
+		name: "nil and empty",
+		in:   object{L: []inner{}, M: map[string]string{}},
+		out:  object{},
+	}, {
+		name:   "shared pointer",
+		in:     object{P: shared},
+		out:    object{P: shared},
+		errors: []string{"object.P: pointer shared by the copy"},
+	}, {
+		name:   "different values",
+		in:     object{S: "a", L: []inner{{I: 1}}, M: map[string]string{"k": "v"}},
+		out:    object{S: "b", L: []inner{{I: 2}}, M: map[string]string{"other": "v"}},
+		errors: []string{`object.S: "a" differs in the copy, "b"`, "object.L[0].I: 1 differs in the copy, 2", "object.M[k]: missing in the copy"},
+	}, {
+		name:   "nil pointer",
+		in:     object{P: &inner{}},
+		out:    object{},
+		errors: []string{"object.P: nil differs in the copy"},
+	}, {
+		name:   "dynamic type",
+		in:     object{Any: 1},
+		out:    object{Any: "1"},
+		errors: []string{"object.Any: dynamic type differs in the copy"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			checkDeepCopy(r, "object", &tc.in, &tc.out)
+			if !reflect.DeepEqual(r.errors, tc.errors) {
+				t.Errorf("got errors %q, expected %q", r.errors, tc.errors)
+			}
+		})
+	}
+
+	slice := []inner{{I: 1}}
+	r := &recorder{TB: t}
+	checkDeepCopy(r, "object", &object{L: slice}, &object{L: slice})
+	if len(r.errors) != 1 || r.errors[0] != "object.L: slice shared by the copy" {
+		t.Errorf("got errors %q for a shared slice", r.errors)
+	}
+}
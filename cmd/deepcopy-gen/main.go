@@ -35,6 +35,10 @@ limitations under the License.
 //	  DeepCopyObject() Object
 //	}
 //
+// With --optimize, arrays and structs holding no references, and slices,
+// maps and pointers of those, are copied by assignment and copy() rather than
+// element by element or with nested DeepCopyInto calls.
+//
 // Generation is governed by comment tags in the source.  Any package may
 // request DeepCopy generation by including a comment in the file-comments of
 // one file, of the form:
@@ -66,10 +70,116 @@ limitations under the License.
 // implement the interface, this can be done with:
 //
 //	// +k8s:deepcopy-gen:nonpointer-interfaces=true
+//
+// Alternatively, members holding interfaces, or slices or maps of interfaces,
+// can list the implementations they may hold:
+//
+//	// +k8s:deepcopy-gen:implementations=k8s.io/my/pkg.Square,*k8s.io/my/pkg.Circle
+//
+// A type switch then copies each implementation, with its DeepCopy method or
+// by assignment for builtins, and panics on any other implementation. This
+// also supports members of type interface{}.
+//
+// DeepCopy functions are generated for generic types too. Their type
+// parameters must be constrained to builtin types, which are copied by
+// assignment, or to types with a DeepCopy method returning the type parameter,
+// e.g.
+//
+//	type Copier[T any] interface {
+//	  DeepCopy() T
+//	}
+//
+//	type List[T Copier[T]] struct {
+//	  Items []T
+//	}
+//
+// Reflection-free DeepEqual methods, treating nil and empty slices and maps as
+// equal like apimachinery's semantic equality, can be generated alongside the
+// deepcopy functions of a package or of a type with the deep-equal parameter:
+//
+//	// +k8s:deepcopy-gen=package,deep-equal
+//	// +k8s:deepcopy-gen=true,deep-equal
+//
+// Members are compared with the DeepEqual methods of their types, defined or
+// generated, or else with their Equal methods, e.g. for resource.Quantity and
+// metav1.Time. Types from other packages without such methods are compared
+// member by member. Interface members are not supported.
+//
+// Types of other packages without deepcopy functions can be copied by
+// functions declared at the package level:
+//
+//	// +k8s:deepcopy-gen:copy-func=k8s.io/my/pkg.DeepCopyInto_Thing
+//
+// The functions must be of the form func(in, out *Type), like DeepCopyInto,
+// and are called on every field, element and pointer of their type.
+//
+// Types read from caches far more often than they are modified can be tagged
+// as having immutable payloads:
+//
+//	// +k8s:deepcopy-gen:immutable-payload=true
+//
+// which generates a Clone method copying the value, and the slices and maps
+// of its members, but sharing the objects they reference. Members of the
+// clone, and the elements of its slices and maps, can then be replaced without
+// a full DeepCopy, as long as the shared objects are never mutated.
+//
+// Fields which must not be copied, e.g. mutexes, channels, funcs or cached
+// computations, are tagged with
+//
+//	// +k8s:deepcopy-gen:skip-field
+//
+// They are zeroed in copies and ignored by DeepEqual. Structs with skipped
+// fields are copied member by member, so that no lock is ever copied.
+//
+// Fields whose values are large and never mutated, e.g. raw byte slices or
+// parsed certificates, can be tagged with
+//
+//	// +k8s:deepcopy-gen:shallow
+//
+// They are copied by assignment, so that copies share what they reference
+// with the original. This also supports types without deepcopy functions.
+//
+// Large structs mostly read by their consumers, e.g. of informers, can be
+// tagged with
+//
+//	// +k8s:deepcopy-gen:copy-on-write=true
+//
+// which generates a <Type>CopyOnWrite wrapper. Copies of the wrapper share the
+// wrapped value and count their references to it, and the first of them to
+// request the value for mutation while it is shared deep-copies it.
+//
+// Structs copied at a high rate, e.g. by controllers, can be tagged with
+//
+//	// +k8s:deepcopy-gen:pooled=true
+//
+// which generates DeepCopyPooled, taking the copy from a sync.Pool, and
+// ReleasePooled, returning a copy no longer in use to the pool. Both use
+// DeepCopyIntoPooled, which reuses the slices, maps and structs the copy
+// already references, and the DeepCopyIntoPooled of the pooled structs it
+// holds.
+//
+// With --with-tests, a test file named after --output-file, e.g.
+// zz_generated.deepcopy_test.go, is generated alongside. For each type whose
+// exported fields can be fuzzed, it checks that DeepCopy returns copies of
+// fuzzed objects which are semantically equal to them but share no pointers,
+// slices or maps with them, including with hand-written DeepCopy methods, and
+// benchmarks DeepCopy. The helpers of the tests are written into the test
+// file too, so that the tested packages do not depend on code-generator, and
+// the test file is not written for packages without such types.
+//
+// With --analyze, nothing is generated. Instead, the types of the input
+// packages whose deepcopy functions cannot be generated, e.g. because of
+// interface, func or channel fields, or types of other packages without
+// deepcopy functions, or would copy locks, are reported on standard error
+// with the tags fixing them, and deepcopy-gen exits with status 1 if there
+// are any. Packages are analyzed as if all their types requested generation,
+// which helps onboarding existing API packages.
 package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
 
 	"github.com/spf13/pflag"
 	"k8s.io/code-generator/cmd/deepcopy-gen/args"
@@ -92,7 +202,15 @@ func main() {
 		klog.Fatalf("Error: %v", err)
 	}
 
+	findings := 0
 	myTargets := func(context *generator.Context) []generator.Target {
+		if args.Analyze {
+			for _, finding := range generators.Analyze(context, args) {
+				fmt.Fprintln(os.Stderr, finding)
+				findings++
+			}
+			return nil
+		}
 		return generators.GetTargets(context, args)
 	}
 
@@ -106,5 +224,9 @@ func main() {
 	); err != nil {
 		klog.Fatalf("Error: %v", err)
 	}
+	if findings > 0 {
+		klog.Errorf("Analysis found %d problem(s)", findings)
+		os.Exit(1)
+	}
 	klog.V(2).Info("Completed successfully.")
 }
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// +k8s:deepcopy-gen=package
+// +k8s:deepcopy-gen=package,deep-equal
 
 // This is a test package.
 package builtins
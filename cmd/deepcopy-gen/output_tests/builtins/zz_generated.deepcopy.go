@@ -36,3 +36,48 @@ func (in *Ttest) DeepCopy() *Ttest {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepEqual is an autogenerated function, reporting whether the receiver and other are deeply
+// equal without using reflection. Nil and empty slices and maps are equal.
+func (in *Ttest) DeepEqual(other *Ttest) bool {
+	if in == other {
+		return true
+	}
+	if in == nil || other == nil {
+		return false
+	}
+	if in.Byte != other.Byte {
+		return false
+	}
+	if in.Int16 != other.Int16 {
+		return false
+	}
+	if in.Int32 != other.Int32 {
+		return false
+	}
+	if in.Int64 != other.Int64 {
+		return false
+	}
+	if in.Uint8 != other.Uint8 {
+		return false
+	}
+	if in.Uint16 != other.Uint16 {
+		return false
+	}
+	if in.Uint32 != other.Uint32 {
+		return false
+	}
+	if in.Uint64 != other.Uint64 {
+		return false
+	}
+	if in.Float32 != other.Float32 {
+		return false
+	}
+	if in.Float64 != other.Float64 {
+		return false
+	}
+	if in.String != other.String {
+		return false
+	}
+	return true
+}
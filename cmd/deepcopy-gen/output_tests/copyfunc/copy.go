@@ -0,0 +1,28 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package copyfunc
+
+import "k8s.io/code-generator/cmd/deepcopy-gen/output_tests/copyfunc/external"
+
+// DeepCopyInto_Thing copies in into out.
+func DeepCopyInto_Thing(in, out *external.Thing) {
+	*out = *in
+	if in.Data != nil {
+		out.Data = make([]byte, len(in.Data))
+		copy(out.Data, in.Data)
+	}
+}
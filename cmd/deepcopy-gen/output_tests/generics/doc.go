@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:deepcopy-gen=package
+
+// This is a test package.
+package generics
+
+// Copier is a constraint for types which can be deep-copied.
+type Copier[T any] interface {
+	DeepCopy() T
+}
+
+// Number is a constraint for builtin types which are copied by assignment.
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+type Item struct {
+	Name  string
+	Names []string
+}
+
+// Container is declared before List, so that its instantiation of List is
+// the first one seen.
+type Container struct {
+	List List[*Item]
+}
+
+type List[T Copier[T]] struct {
+	Items  []T
+	ByName map[string]T
+	First  T
+	Count  int
+}
+
+type Box[T Number] struct {
+	Value  T
+	Values []T
+	Ptr    *T
+	Map    map[string]T
+}
+
+type Node[K ~string, V Number] struct {
+	Key   K
+	Value Box[V]
+	Next  *Node[K, V]
+}
+
+type Ttest struct {
+	Container Container
+	Lists     []List[*Item]
+	Boxes     map[string]Box[int64]
+	Node      *Node[string, float64]
+}
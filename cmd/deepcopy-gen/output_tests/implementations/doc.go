@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:deepcopy-gen=package
+
+// This is a test package.
+package implementations
+
+type Shape interface {
+	Area() float64
+}
+
+type Square struct {
+	Side float64
+	Tags []string
+}
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+type Circle struct {
+	Radius *float64
+}
+
+func (c *Circle) Area() float64 { return 3 * *c.Radius * *c.Radius }
+
+type Ttest struct {
+	// +k8s:deepcopy-gen:implementations=k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations.Square
+	// +k8s:deepcopy-gen:implementations=*k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations.Circle
+	Shape Shape
+	// +k8s:deepcopy-gen:implementations=k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations.Square,*k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations.Circle
+	Shapes []Shape
+	// +k8s:deepcopy-gen:implementations=k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations.Square,*k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations.Circle
+	ShapeMap map[string]Shape
+	// +k8s:deepcopy-gen:implementations=string,*int64,*k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations.Circle
+	Any interface{}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/google/gofuzz"
 
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/aliases"
+	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations"
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/interfaces"
 )
 
@@ -84,6 +85,35 @@ var interfaceFuzzers = []interface{}{
 			*s = &interfacesInnerInstance{X: c.Float64()}
 		}
 	},
+	func(s *implementations.Shape, c fuzz.Continue) {
+		switch c.Intn(3) {
+		case 0:
+			*s = nil
+		case 1:
+			square := implementations.Square{}
+			c.Fuzz(&square)
+			*s = square
+		default:
+			circle := &implementations.Circle{}
+			c.Fuzz(circle)
+			*s = circle
+		}
+	},
+	func(s *interface{}, c fuzz.Continue) {
+		switch c.Intn(4) {
+		case 0:
+			*s = nil
+		case 1:
+			*s = c.RandString()
+		case 2:
+			i := c.Int63()
+			*s = &i
+		default:
+			circle := &implementations.Circle{}
+			c.Fuzz(circle)
+			*s = circle
+		}
+	},
 }
 
 type aliasAliasInterfaceInstance struct {
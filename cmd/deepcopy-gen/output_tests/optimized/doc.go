@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:deepcopy-gen=package
+
+// This is a test package, generated with --optimize.
+package optimized
+
+type Point struct {
+	X, Y float64
+}
+
+type Bounds struct {
+	Corners [4]Point
+	Labels  [2]string
+}
+
+type Shape struct {
+	Name   string
+	Bounds Bounds
+	Points []Point
+}
+
+type Ttest struct {
+	Bounds      Bounds
+	BoundsSlice []Bounds
+	BoundsMap   map[string]Bounds
+	BoundsPtr   *Bounds
+	Matrix      [3][3]int
+	Shapes      []Shape
+	ShapePtrs   []*Shape
+}
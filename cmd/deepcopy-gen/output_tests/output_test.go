@@ -23,12 +23,20 @@ import (
 
 	fuzz "github.com/google/gofuzz"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/util/dump"
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/aliases"
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/builtins"
+	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/copyfunc"
+	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/cow"
+	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/generics"
+	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/immutable"
+	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/implementations"
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/interfaces"
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/maps"
+	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/optimized"
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/pointer"
+	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/pooled"
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/slices"
 	"k8s.io/code-generator/cmd/deepcopy-gen/output_tests/structs"
 )
@@ -37,9 +45,16 @@ func TestWithValueFuzzer(t *testing.T) {
 	tests := []interface{}{
 		aliases.Ttest{},
 		builtins.Ttest{},
+		copyfunc.Ttest{},
+		cow.Ttest{},
+		generics.Ttest{},
+		immutable.Ttest{},
+		implementations.Ttest{},
 		interfaces.Ttest{},
 		maps.Ttest{},
+		optimized.Ttest{},
 		pointer.Ttest{},
+		pooled.Ttest{},
 		slices.Ttest{},
 		structs.Ttest{},
 	}
@@ -79,6 +94,152 @@ func TestWithValueFuzzer(t *testing.T) {
 	}
 }
 
+func TestDeepEqualWithValueFuzzer(t *testing.T) {
+	tests := []interface{}{
+		builtins.Ttest{},
+		maps.Ttest{},
+		pointer.Ttest{},
+		slices.Ttest{},
+		structs.Ttest{},
+	}
+
+	fuzzer := fuzz.New()
+	fuzzer.NilChance(0.5)
+	fuzzer.NumElements(0, 2)
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%T", test), func(t *testing.T) {
+			N := 1000
+			for i := 0; i < N; i++ {
+				original := reflect.New(reflect.TypeOf(test)).Interface()
+				fuzzer.Fuzz(original)
+
+				deepCopy := reflect.ValueOf(original).MethodByName("DeepCopy").Call(nil)[0].Interface()
+				if !deepEqual(original, deepCopy) {
+					t.Fatalf("original and deepCopy are not DeepEqual:\n\n  original = %s\n\n  deepCopy() = %s", dump.Pretty(original), dump.Pretty(deepCopy))
+				}
+
+				other := reflect.New(reflect.TypeOf(test)).Interface()
+				if i%2 == 0 {
+					ValueFuzz(deepCopy)
+					other = deepCopy
+				} else {
+					fuzzer.Fuzz(other)
+				}
+				if expected, got := equality.Semantic.DeepEqual(original, other), deepEqual(original, other); expected != got {
+					t.Fatalf("DeepEqual returned %t, semantic equality %t:\n\n  original = %s\n\n  other = %s", got, expected, dump.Pretty(original), dump.Pretty(other))
+				}
+			}
+		})
+	}
+}
+
+func TestCloneWithValueFuzzer(t *testing.T) {
+	fuzzer := fuzz.New()
+	fuzzer.NilChance(0.5)
+	fuzzer.NumElements(0, 2)
+
+	N := 1000
+	for i := 0; i < N; i++ {
+		original := &immutable.Ttest{}
+		fuzzer.Fuzz(original)
+		reflectCopy := ReflectDeepCopy(original)
+
+		clone := original.Clone()
+		if !reflect.DeepEqual(original, clone) {
+			t.Fatalf("original and clone are different:\n\n  original = %s\n\n  clone = %s", dump.Pretty(original), dump.Pretty(clone))
+		}
+		if clone.Payload != original.Payload {
+			t.Fatalf("clone does not share the payload of original")
+		}
+
+		// Replacing the members of the clone, and the elements of its slices
+		// and maps, must leave the original unchanged.
+		clone.Name += "x"
+		clone.Payload = nil
+		for key := range clone.Labels {
+			clone.Labels[key] += "x"
+		}
+		for i := range clone.Payloads {
+			clone.Payloads[i] = nil
+		}
+		for key := range clone.Index {
+			clone.Index[key] = nil
+		}
+		for i := range clone.Nested {
+			clone.Nested[i] = immutable.Payload{}
+		}
+		if !reflect.DeepEqual(original, reflectCopy) {
+			t.Fatalf("original changed with its clone:\n\n  original = %s\n\n  reflectCopy = %s", dump.Pretty(original), dump.Pretty(reflectCopy))
+		}
+	}
+}
+
+func TestCopyOnWriteWithValueFuzzer(t *testing.T) {
+	fuzzer := fuzz.New()
+	fuzzer.NilChance(0.5)
+	fuzzer.NumElements(0, 2)
+
+	N := 1000
+	for i := 0; i < N; i++ {
+		original := &cow.Ttest{}
+		fuzzer.Fuzz(original)
+		reflectCopy := ReflectDeepCopy(original)
+
+		w := cow.NewTtestCopyOnWrite(original)
+		c := w.Copy()
+		if c.Get() != original {
+			t.Fatalf("copy does not share the wrapped value")
+		}
+
+		// Mutating the copy must leave the original unchanged.
+		ValueFuzz(c.Mutable())
+		if !reflect.DeepEqual(original, reflectCopy) {
+			t.Fatalf("original changed with its copy:\n\n  original = %s\n\n  reflectCopy = %s", dump.Pretty(original), dump.Pretty(reflectCopy))
+		}
+		if c.Get() == original {
+			t.Fatalf("mutable copy still shares the wrapped value")
+		}
+
+		// The value is not copied anymore once it is not shared.
+		if w.Mutable() != original {
+			t.Fatalf("unshared value was copied")
+		}
+		c.Release()
+	}
+}
+
+func TestPooledWithValueFuzzer(t *testing.T) {
+	fuzzer := fuzz.New()
+	fuzzer.NilChance(0.5)
+	fuzzer.NumElements(0, 2)
+
+	N := 1000
+	for i := 0; i < N; i++ {
+		original := &pooled.Ttest{}
+		fuzzer.Fuzz(original)
+		reflectCopy := ReflectDeepCopy(original)
+
+		// The pool returns the copies released in previous iterations, whose
+		// allocations are reused.
+		deepCopy := original.DeepCopyPooled()
+		if !reflect.DeepEqual(original, deepCopy) {
+			t.Fatalf("original and deepCopy are different:\n\n  original = %s\n\n  deepCopy = %s", dump.Pretty(original), dump.Pretty(deepCopy))
+		}
+
+		ValueFuzz(deepCopy)
+		if !reflect.DeepEqual(original, reflectCopy) {
+			t.Fatalf("original changed with its copy:\n\n  original = %s\n\n  reflectCopy = %s", dump.Pretty(original), dump.Pretty(reflectCopy))
+		}
+		deepCopy.ReleasePooled()
+	}
+}
+
+// deepEqual calls the DeepEqual method of a.
+func deepEqual(a, b interface{}) bool {
+	return reflect.ValueOf(a).MethodByName("DeepEqual").Call([]reflect.Value{reflect.ValueOf(b)})[0].Bool()
+}
+
 func BenchmarkReflectDeepCopy(b *testing.B) {
 	fourtytwo := "fourtytwo"
 	fourtytwoPtr := &fourtytwo
@@ -112,3 +112,157 @@ func (in *Ttest) DeepCopy() *Ttest {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepEqual is an autogenerated function, reporting whether the receiver and other are deeply
+// equal without using reflection. Nil and empty slices and maps are equal.
+func (in *Ttest) DeepEqual(other *Ttest) bool {
+	if in == other {
+		return true
+	}
+	if in == nil || other == nil {
+		return false
+	}
+	{
+		in, other := &in.Builtin, &other.Builtin
+		if (*in == nil) != (*other == nil) {
+			return false
+		}
+		if *in != nil && **in != **other {
+			return false
+		}
+	}
+	{
+		in, other := &in.Ptr, &other.Ptr
+		if (*in == nil) != (*other == nil) {
+			return false
+		}
+		if *in != nil {
+			in, other := *in, *other
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil && **in != **other {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Map, &other.Map
+		if (*in == nil) != (*other == nil) {
+			return false
+		}
+		if *in != nil {
+			in, other := *in, *other
+			if len(*in) != len(*other) {
+				return false
+			}
+			for key, val := range *in {
+				otherVal, ok := (*other)[key]
+				if !ok {
+					return false
+				}
+				if val != otherVal {
+					return false
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.Slice, &other.Slice
+		if (*in == nil) != (*other == nil) {
+			return false
+		}
+		if *in != nil {
+			in, other := *in, *other
+			if len(*in) != len(*other) {
+				return false
+			}
+			for i := range *in {
+				if (*in)[i] != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.MapPtr, &other.MapPtr
+		if (*in == nil) != (*other == nil) {
+			return false
+		}
+		if *in != nil {
+			in, other := *in, *other
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil {
+				in, other := *in, *other
+				if len(*in) != len(*other) {
+					return false
+				}
+				for key, val := range *in {
+					otherVal, ok := (*other)[key]
+					if !ok {
+						return false
+					}
+					if val != otherVal {
+						return false
+					}
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.SlicePtr, &other.SlicePtr
+		if (*in == nil) != (*other == nil) {
+			return false
+		}
+		if *in != nil {
+			in, other := *in, *other
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil {
+				in, other := *in, *other
+				if len(*in) != len(*other) {
+					return false
+				}
+				for i := range *in {
+					if (*in)[i] != (*other)[i] {
+						return false
+					}
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.Struct, &other.Struct
+		if (*in == nil) != (*other == nil) {
+			return false
+		}
+		if *in != nil {
+			in, other := *in, *other
+			if !in.DeepEqual(other) {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.StructPtr, &other.StructPtr
+		if (*in == nil) != (*other == nil) {
+			return false
+		}
+		if *in != nil {
+			in, other := *in, *other
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil {
+				in, other := *in, *other
+				if !in.DeepEqual(other) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
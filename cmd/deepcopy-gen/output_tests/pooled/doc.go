@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:deepcopy-gen=package
+
+// This is a test package.
+package pooled
+
+type Point struct {
+	X, Y int
+}
+
+// +k8s:deepcopy-gen:pooled=true
+type Container struct {
+	Name  string
+	Ports []int
+	Env   map[string]string
+}
+
+type Volume struct {
+	Name   string
+	Source *string
+}
+
+// +k8s:deepcopy-gen:pooled=true
+type Ttest struct {
+	Name        string
+	Labels      map[string]string
+	Points      []Point
+	Point       *Point
+	Containers  []Container
+	Container   *Container
+	Main        Container
+	Volumes     []Volume
+	VolumePtrs  []*Volume
+	Annotations map[string][]string
+}
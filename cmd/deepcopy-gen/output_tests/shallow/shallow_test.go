@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shallow
+
+import (
+	"crypto/x509"
+	"testing"
+)
+
+func TestShallow(t *testing.T) {
+	original := &Ttest{
+		Name:        "name",
+		Raw:         []byte("raw"),
+		Certificate: &x509.Certificate{},
+		Copied:      []byte("copied"),
+		Labels:      map[string]string{"a": "b"},
+		Secret:      Secret{Name: "secret", Data: []byte("data")},
+		Secrets:     []Secret{{Name: "a", Data: []byte("a")}},
+	}
+
+	for name, copy := range map[string]*Ttest{
+		"DeepCopy":       original.DeepCopy(),
+		"DeepCopyPooled": original.DeepCopyPooled(),
+		"Clone":          original.Clone(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if &copy.Raw[0] != &original.Raw[0] || copy.Certificate != original.Certificate {
+				t.Errorf("shallow fields were deep-copied")
+			}
+			if &copy.Secret.Data[0] != &original.Secret.Data[0] || &copy.Secrets[0].Data[0] != &original.Secrets[0].Data[0] {
+				t.Errorf("shallow fields of members were deep-copied")
+			}
+			if name != "Clone" && &copy.Copied[0] == &original.Copied[0] {
+				t.Errorf("fields were copied shallowly")
+			}
+			if copy.Name != original.Name || string(copy.Copied) != string(original.Copied) || copy.Labels["a"] != "b" {
+				t.Errorf("fields were not copied: %#v", copy)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +k8s:deepcopy-gen=package,deep-equal
+
+// This is a test package.
+package skipfield
+
+import "sync"
+
+type Cache struct {
+	// +k8s:deepcopy-gen:skip-field
+	mu    sync.Mutex
+	Value string
+}
+
+// +k8s:deepcopy-gen:immutable-payload=true
+type Ttest struct {
+	Name string
+	// +k8s:deepcopy-gen:skip-field
+	Lock sync.RWMutex
+	// +k8s:deepcopy-gen:skip-field
+	Done chan struct{}
+	// +k8s:deepcopy-gen:skip-field
+	Compute func() int
+	// +k8s:deepcopy-gen:skip-field
+	cached *string
+	// +k8s:deepcopy-gen:skip-field
+	hits int
+	// +k8s:deepcopy-gen:skip-field
+	seen   map[string]bool
+	Cache  Cache
+	Caches []Cache
+	Labels map[string]string
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package skipfield
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSkipField(t *testing.T) {
+	cached := "cached"
+	original := &Ttest{
+		Name:    "name",
+		Done:    make(chan struct{}),
+		Compute: func() int { return 42 },
+		cached:  &cached,
+		hits:    3,
+		seen:    map[string]bool{"a": true},
+		Cache:   Cache{Value: "value"},
+		Caches:  []Cache{{Value: "a"}, {Value: "b"}},
+		Labels:  map[string]string{"a": "b"},
+	}
+	original.Lock.Lock()
+	original.Cache.mu.Lock()
+	original.Caches[0].mu.Lock()
+	defer original.Lock.Unlock()
+
+	for name, copy := range map[string]*Ttest{
+		"DeepCopy": original.DeepCopy(),
+		"Clone":    original.Clone(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if copy.Done != nil || copy.Compute != nil || copy.cached != nil || copy.hits != 0 || copy.seen != nil {
+				t.Errorf("skipped fields were copied: %#v", copy)
+			}
+			if !copy.Lock.TryLock() || !copy.Cache.mu.TryLock() || !copy.Caches[0].mu.TryLock() {
+				t.Errorf("skipped mutexes were copied while locked")
+			}
+			if copy.Name != original.Name || copy.Cache.Value != original.Cache.Value || !reflect.DeepEqual(copy.Labels, original.Labels) {
+				t.Errorf("fields were not copied: %#v", copy)
+			}
+			if !original.DeepEqual(copy) {
+				t.Errorf("skipped fields were compared")
+			}
+		})
+	}
+}
@@ -191,3 +191,290 @@ func (in *Ttest) DeepCopy() *Ttest {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepEqual is an autogenerated function, reporting whether the receiver and other are deeply
+// equal without using reflection. Nil and empty slices and maps are equal.
+func (in *Ttest) DeepEqual(other *Ttest) bool {
+	if in == other {
+		return true
+	}
+	if in == nil || other == nil {
+		return false
+	}
+	{
+		in, other := &in.Byte, &other.Byte
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Int16, &other.Int16
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Int32, &other.Int32
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Int64, &other.Int64
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Uint8, &other.Uint8
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Uint16, &other.Uint16
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Uint32, &other.Uint32
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Uint64, &other.Uint64
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Float32, &other.Float32
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.Float64, &other.Float64
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.String, &other.String
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			if (*in)[i] != (*other)[i] {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.StringPtr, &other.StringPtr
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			in, other := &(*in)[i], &(*other)[i]
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil && **in != **other {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.StringPtrPtr, &other.StringPtrPtr
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			in, other := &(*in)[i], &(*other)[i]
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil {
+				in, other := *in, *other
+				if (*in == nil) != (*other == nil) {
+					return false
+				}
+				if *in != nil && **in != **other {
+					return false
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.Map, &other.Map
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			in, other := &(*in)[i], &(*other)[i]
+			if len(*in) != len(*other) {
+				return false
+			}
+			for key, val := range *in {
+				otherVal, ok := (*other)[key]
+				if !ok {
+					return false
+				}
+				if val != otherVal {
+					return false
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.MapPtr, &other.MapPtr
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			in, other := &(*in)[i], &(*other)[i]
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil {
+				in, other := *in, *other
+				if len(*in) != len(*other) {
+					return false
+				}
+				for key, val := range *in {
+					otherVal, ok := (*other)[key]
+					if !ok {
+						return false
+					}
+					if val != otherVal {
+						return false
+					}
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.Slice, &other.Slice
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			in, other := &(*in)[i], &(*other)[i]
+			if len(*in) != len(*other) {
+				return false
+			}
+			for i := range *in {
+				if (*in)[i] != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.SlicePtr, &other.SlicePtr
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			in, other := &(*in)[i], &(*other)[i]
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil {
+				in, other := *in, *other
+				if len(*in) != len(*other) {
+					return false
+				}
+				for i := range *in {
+					if (*in)[i] != (*other)[i] {
+						return false
+					}
+				}
+			}
+		}
+	}
+	{
+		in, other := &in.Struct, &other.Struct
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			in, other := &(*in)[i], &(*other)[i]
+			if !in.DeepEqual(other) {
+				return false
+			}
+		}
+	}
+	{
+		in, other := &in.StructPtr, &other.StructPtr
+		if len(*in) != len(*other) {
+			return false
+		}
+		for i := range *in {
+			in, other := &(*in)[i], &(*other)[i]
+			if (*in == nil) != (*other == nil) {
+				return false
+			}
+			if *in != nil {
+				in, other := *in, *other
+				if !in.DeepEqual(other) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
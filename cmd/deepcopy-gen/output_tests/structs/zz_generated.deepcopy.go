@@ -37,6 +37,51 @@ func (in *Inner) DeepCopy() *Inner {
 	return out
 }
 
+// DeepEqual is an autogenerated function, reporting whether the receiver and other are deeply
+// equal without using reflection. Nil and empty slices and maps are equal.
+func (in *Inner) DeepEqual(other *Inner) bool {
+	if in == other {
+		return true
+	}
+	if in == nil || other == nil {
+		return false
+	}
+	if in.Byte != other.Byte {
+		return false
+	}
+	if in.Int16 != other.Int16 {
+		return false
+	}
+	if in.Int32 != other.Int32 {
+		return false
+	}
+	if in.Int64 != other.Int64 {
+		return false
+	}
+	if in.Uint8 != other.Uint8 {
+		return false
+	}
+	if in.Uint16 != other.Uint16 {
+		return false
+	}
+	if in.Uint32 != other.Uint32 {
+		return false
+	}
+	if in.Uint64 != other.Uint64 {
+		return false
+	}
+	if in.Float32 != other.Float32 {
+		return false
+	}
+	if in.Float64 != other.Float64 {
+		return false
+	}
+	if in.String != other.String {
+		return false
+	}
+	return true
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ttest) DeepCopyInto(out *Ttest) {
 	*out = *in
@@ -54,3 +99,27 @@ func (in *Ttest) DeepCopy() *Ttest {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepEqual is an autogenerated function, reporting whether the receiver and other are deeply
+// equal without using reflection. Nil and empty slices and maps are equal.
+func (in *Ttest) DeepEqual(other *Ttest) bool {
+	if in == other {
+		return true
+	}
+	if in == nil || other == nil {
+		return false
+	}
+	{
+		in, other := &in.Inner1, &other.Inner1
+		if !in.DeepEqual(other) {
+			return false
+		}
+	}
+	{
+		in, other := &in.Inner2, &other.Inner2
+		if !in.DeepEqual(other) {
+			return false
+		}
+	}
+	return true
+}
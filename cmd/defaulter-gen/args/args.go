@@ -18,6 +18,7 @@ package args
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/pflag"
 
@@ -34,6 +35,23 @@ type Args struct {
 	// groups of generators (external API that depends on Kube generations) should
 	// keep tags distinct as well.
 	GeneratedBuildTag string
+
+	// DefaultsManifestFile, if set, is the name of a JSON manifest of the
+	// defaults declared by markers, written next to the generated defaulters.
+	DefaultsManifestFile string
+
+	// GoldenTestFile, if set, is the name of a test file to generate next to
+	// the defaulters, which compares defaulted zero-valued objects against
+	// golden JSON files.
+	GoldenTestFile string
+
+	// DefaultsRecorder, if true, also generates variants of the object
+	// defaulters which report the fields they default to a recorder.
+	DefaultsRecorder bool
+
+	// KindDefaulters, if true, also generates a map from GroupVersionKind to
+	// the object defaulters, for applying defaults without a scheme.
+	KindDefaulters bool
 }
 
 // New returns default arguments for the generator.
@@ -52,6 +70,14 @@ func (args *Args) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&args.GoHeaderFile, "go-header-file", "",
 		"the path to a file containing boilerplate header text; the string \"YEAR\" will be replaced with the current 4-digit year")
 	fs.StringVar(&args.GeneratedBuildTag, "build-tag", args.GeneratedBuildTag, "A Go build tag to use to identify files generated by this command. Should be unique.")
+	fs.StringVar(&args.DefaultsManifestFile, "defaults-manifest-file", "",
+		"the name of a JSON manifest of the defaults declared by markers to generate alongside the defaulters, if any")
+	fs.StringVar(&args.GoldenTestFile, "golden-test-file", "",
+		"the name of a test file comparing defaulted zero-valued objects against golden JSON files to generate alongside the defaulters, if any")
+	fs.BoolVar(&args.DefaultsRecorder, "defaults-recorder", false,
+		"also generate SetObjectDefaultsWithRecorder_ variants of the object defaulters, which report the paths of the fields defaulted by markers to a DefaultsRecorder")
+	fs.BoolVar(&args.KindDefaulters, "kind-defaulters", false,
+		"also generate KindDefaulters, a map from GroupVersionKind to the object defaulters, and SetObjectDefaultsForKind, for applying defaults without a scheme")
 }
 
 // Validate checks the given arguments.
@@ -59,6 +85,9 @@ func (args *Args) Validate() error {
 	if len(args.OutputFile) == 0 {
 		return fmt.Errorf("--output-file must be specified")
 	}
+	if len(args.GoldenTestFile) > 0 && !strings.HasSuffix(args.GoldenTestFile, "_test.go") {
+		return fmt.Errorf("--golden-test-file must end with _test.go")
+	}
 
 	return nil
 }
@@ -0,0 +1,813 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+// celType is the type of a CEL (sub)expression.
+type celType string
+
+const (
+	celInt    celType = "int"
+	celUint   celType = "uint"
+	celDouble celType = "double"
+	celString celType = "string"
+	celBool   celType = "bool"
+	// Lists and maps can only be passed to size().
+	celList celType = "list"
+	celMap  celType = "map"
+)
+
+// goTypes maps the scalar CEL types to the Go types used to evaluate them.
+var goTypes = map[celType]string{
+	celInt:    "int64",
+	celUint:   "uint64",
+	celDouble: "float64",
+	celString: "string",
+	celBool:   "bool",
+}
+
+// celTypeOf returns the CEL type of a builtin Go type.
+func celTypeOf(t *types.Type) (celType, bool) {
+	switch t.Kind {
+	case types.Builtin:
+		switch t.Name.Name {
+		case "int", "int8", "int16", "int32", "int64":
+			return celInt, true
+		case "uint", "uint8", "uint16", "uint32", "uint64", "byte":
+			return celUint, true
+		case "float32", "float64":
+			return celDouble, true
+		case "string":
+			return celString, true
+		case "bool":
+			return celBool, true
+		}
+	case types.Slice, types.Array:
+		return celList, true
+	case types.Map:
+		return celMap, true
+	}
+	return "", false
+}
+
+// Precedence of compiled Go expressions, from loosest to tightest binding.
+const (
+	precOr = iota + 1
+	precAnd
+	precCompare
+	precAdd
+	precMul
+	precUnary
+	precPrimary
+)
+
+// celExpression is a CEL expression which has been type-checked against a
+// struct and compiled to an equivalent Go expression.
+type celExpression struct {
+	typ  celType
+	prec int
+	// checked is true if code calls the checked arithmetic helpers, which
+	// clear the bool "ok" on integer overflow or division by zero.
+	checked bool
+	// code renders the Go expression, given the Go expression which
+	// evaluates to (a pointer to) the struct bound to "self".
+	code func(self string) string
+}
+
+// wrap renders e, parenthesized if it binds more loosely than prec.
+func (e *celExpression) wrap(self string, prec int) string {
+	if e.prec < prec {
+		return "(" + e.code(self) + ")"
+	}
+	return e.code(self)
+}
+
+// compileCELDefault compiles a "+default:cel=" expression. The supported
+// subset of CEL covers literals, field selection on "self" (using JSON field
+// names), arithmetic, comparisons, logical operators, the conditional operator,
+// size() and the int(), uint() and double() conversions. "self" is the struct
+// which declares the defaulted field.
+func compileCELDefault(expr string, self *types.Type) (*celExpression, error) {
+	tokens, err := tokenizeCEL(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &celParser{tokens: tokens, self: self}
+	e, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	if _, ok := goTypes[e.typ]; !ok {
+		return nil, fmt.Errorf("expression has unsupported type %s", e.typ)
+	}
+	return e, nil
+}
+
+type celTokenKind int
+
+const (
+	celTokenIdent celTokenKind = iota
+	celTokenInt
+	celTokenUint
+	celTokenDouble
+	celTokenString
+	celTokenPunct
+)
+
+type celToken struct {
+	kind celTokenKind
+	text string
+}
+
+var celPunctuation = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "+", "-", "*", "/", "%", "!", "?", ":", "(", ")", ".", ","}
+
+func tokenizeCEL(s string) ([]celToken, error) {
+	var tokens []celToken
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '_' || unicode.IsLetter(c):
+			j := i + 1
+			for j < len(s) && (s[j] == '_' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			tokens = append(tokens, celToken{celTokenIdent, s[i:j]})
+			i = j
+		case unicode.IsDigit(c):
+			j := i
+			kind := celTokenInt
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.' || s[j] == 'e' || s[j] == 'E' ||
+				((s[j] == '+' || s[j] == '-') && (s[j-1] == 'e' || s[j-1] == 'E'))) {
+				if !unicode.IsDigit(rune(s[j])) {
+					kind = celTokenDouble
+				}
+				j++
+			}
+			text := s[i:j]
+			if kind == celTokenInt && j < len(s) && (s[j] == 'u' || s[j] == 'U') {
+				kind = celTokenUint
+				j++
+			}
+			tokens = append(tokens, celToken{kind, text})
+			i = j
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(s) && s[j] != s[i] {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string literal at offset %d", i)
+			}
+			body := s[i+1 : j]
+			if c == '\'' {
+				body = strings.ReplaceAll(strings.ReplaceAll(body, `\'`, `'`), `"`, `\"`)
+			}
+			value, err := strconv.Unquote(`"` + body + `"`)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string literal %s", s[i:j+1])
+			}
+			tokens = append(tokens, celToken{celTokenString, value})
+			i = j + 1
+		default:
+			found := false
+			for _, p := range celPunctuation {
+				if strings.HasPrefix(s[i:], p) {
+					tokens = append(tokens, celToken{celTokenPunct, p})
+					i += len(p)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unexpected character %q at offset %d", c, i)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+type celParser struct {
+	tokens []celToken
+	pos    int
+	self   *types.Type
+}
+
+// accept consumes the next token if it is the given punctuation.
+func (p *celParser) accept(punct string) bool {
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == celTokenPunct && p.tokens[p.pos].text == punct {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *celParser) expect(punct string) error {
+	if !p.accept(punct) {
+		if p.pos < len(p.tokens) {
+			return fmt.Errorf("expected %q, found %q", punct, p.tokens[p.pos].text)
+		}
+		return fmt.Errorf("expected %q at end of expression", punct)
+	}
+	return nil
+}
+
+func (p *celParser) parseConditional() (*celExpression, error) {
+	cond, err := p.parseBinary(precOr)
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("?") {
+		return cond, nil
+	}
+	if cond.typ != celBool {
+		return nil, fmt.Errorf("condition must be bool, not %s", cond.typ)
+	}
+	then, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+	if then.typ != otherwise.typ {
+		return nil, fmt.Errorf("branches of conditional have different types %s and %s", then.typ, otherwise.typ)
+	}
+	goType, ok := goTypes[then.typ]
+	if !ok {
+		return nil, fmt.Errorf("conditional has unsupported type %s", then.typ)
+	}
+	return &celExpression{
+		typ:     then.typ,
+		prec:    precPrimary,
+		checked: cond.checked || then.checked || otherwise.checked,
+		code: func(self string) string {
+			return fmt.Sprintf("func() %s {\nif %s {\nreturn %s\n}\nreturn %s\n}()", goType, cond.code(self), then.code(self), otherwise.code(self))
+		},
+	}, nil
+}
+
+// celBinaryOperators lists the binary operators by precedence.
+var celBinaryOperators = map[int][]string{
+	precOr:      {"||"},
+	precAnd:     {"&&"},
+	precCompare: {"==", "!=", "<=", ">=", "<", ">"},
+	precAdd:     {"+", "-"},
+	precMul:     {"*", "/", "%"},
+}
+
+func (p *celParser) parseBinary(prec int) (*celExpression, error) {
+	if prec == precUnary {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(prec + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := ""
+		for _, candidate := range celBinaryOperators[prec] {
+			if p.accept(candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+		right, err := p.parseBinary(prec + 1)
+		if err != nil {
+			return nil, err
+		}
+		if left.typ != right.typ {
+			return nil, fmt.Errorf("operator %s cannot be applied to %s and %s", op, left.typ, right.typ)
+		}
+		typ := left.typ
+		switch prec {
+		case precOr, precAnd:
+			if typ != celBool {
+				return nil, fmt.Errorf("operator %s cannot be applied to %s", op, typ)
+			}
+		case precCompare:
+			if _, ok := goTypes[typ]; !ok || (typ == celBool && op != "==" && op != "!=") {
+				return nil, fmt.Errorf("operator %s cannot be applied to %s", op, typ)
+			}
+			typ = celBool
+		case precAdd:
+			if typ == celBool || (typ == celString && op != "+") {
+				return nil, fmt.Errorf("operator %s cannot be applied to %s", op, typ)
+			}
+		case precMul:
+			if typ != celInt && typ != celUint && !(typ == celDouble && op != "%") {
+				return nil, fmt.Errorf("operator %s cannot be applied to %s", op, typ)
+			}
+		}
+		if _, ok := goTypes[typ]; !ok {
+			return nil, fmt.Errorf("operator %s cannot be applied to %s", op, typ)
+		}
+		l, r, opPrec := left, right, prec
+		if helper, ok := checkedOperators[op]; ok && (typ == celInt || typ == celUint) {
+			left = checkedCall(helper+celHelperTypes[typ], typ, l, r)
+			continue
+		}
+		left = &celExpression{
+			typ:     typ,
+			prec:    prec,
+			checked: l.checked || r.checked,
+			code: func(self string) string {
+				return l.wrap(self, opPrec) + " " + op + " " + r.wrap(self, opPrec+1)
+			},
+		}
+	}
+}
+
+// checkedOperators maps the integer operators which may overflow or divide by
+// zero, which are errors in CEL, to their checked arithmetic helpers.
+var checkedOperators = map[string]string{
+	"+": "Add",
+	"-": "Sub",
+	"*": "Mul",
+	"/": "Div",
+	"%": "Rem",
+}
+
+// celHelperTypes names the Go types of the CEL numeric types in the names of
+// the checked arithmetic helpers.
+var celHelperTypes = map[celType]string{
+	celInt:    "Int64",
+	celUint:   "Uint64",
+	celDouble: "Float64",
+}
+
+// checkedCall compiles a call to the checked arithmetic helper with the given
+// name.
+func checkedCall(helper string, typ celType, args ...*celExpression) *celExpression {
+	return &celExpression{
+		typ:     typ,
+		prec:    precPrimary,
+		checked: true,
+		code: func(self string) string {
+			var code []string
+			for _, arg := range args {
+				code = append(code, arg.code(self))
+			}
+			return celHelperPrefix + helper + "(" + strings.Join(append(code, "&ok"), ", ") + ")"
+		},
+	}
+}
+
+func (p *celParser) parseUnary() (*celExpression, error) {
+	for _, op := range []string{"!", "-"} {
+		if !p.accept(op) {
+			continue
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if (op == "!" && operand.typ != celBool) || (op == "-" && operand.typ != celInt && operand.typ != celDouble) {
+			return nil, fmt.Errorf("operator %s cannot be applied to %s", op, operand.typ)
+		}
+		if op == "-" && operand.typ == celInt {
+			return checkedCall("Neg"+celHelperTypes[celInt], celInt, operand), nil
+		}
+		return &celExpression{
+			typ:     operand.typ,
+			prec:    precUnary,
+			checked: operand.checked,
+			code: func(self string) string {
+				return op + operand.wrap(self, precPrimary)
+			},
+		}, nil
+	}
+	return p.parsePrimary()
+}
+
+func constant(typ celType, code string) *celExpression {
+	return &celExpression{
+		typ:  typ,
+		prec: precPrimary,
+		code: func(string) string { return code },
+	}
+}
+
+func (p *celParser) parsePrimary() (*celExpression, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	switch tok.kind {
+	case celTokenInt:
+		if _, err := strconv.ParseInt(tok.text, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid int literal %s", tok.text)
+		}
+		return constant(celInt, tok.text), nil
+	case celTokenUint:
+		if _, err := strconv.ParseUint(tok.text, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid uint literal %s", tok.text)
+		}
+		return constant(celUint, tok.text), nil
+	case celTokenDouble:
+		if _, err := strconv.ParseFloat(tok.text, 64); err != nil {
+			return nil, fmt.Errorf("invalid double literal %s", tok.text)
+		}
+		return constant(celDouble, tok.text), nil
+	case celTokenString:
+		return constant(celString, strconv.Quote(tok.text)), nil
+	case celTokenPunct:
+		if tok.text != "(" {
+			return nil, fmt.Errorf("unexpected %q", tok.text)
+		}
+		e, err := p.parseConditional()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	}
+
+	switch tok.text {
+	case "true", "false":
+		return constant(celBool, tok.text), nil
+	case "self":
+		return p.parseSelection()
+	case "size", "int", "uint", "double":
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		arg, err := p.parseConditional()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return celCall(tok.text, arg)
+	}
+	return nil, fmt.Errorf("undeclared reference to %q", tok.text)
+}
+
+func celCall(fn string, arg *celExpression) (*celExpression, error) {
+	if fn == "size" {
+		if arg.typ != celString && arg.typ != celList && arg.typ != celMap {
+			return nil, fmt.Errorf("size() cannot be applied to %s", arg.typ)
+		}
+		return &celExpression{
+			typ:     celInt,
+			prec:    precPrimary,
+			checked: arg.checked,
+			code:    func(self string) string { return "int64(len(" + arg.code(self) + "))" },
+		}, nil
+	}
+	typ := celType(fn)
+	if arg.typ != celInt && arg.typ != celUint && arg.typ != celDouble {
+		return nil, fmt.Errorf("%s() cannot be applied to %s", fn, arg.typ)
+	}
+	if arg.typ == typ {
+		return arg, nil
+	}
+	if typ != celDouble {
+		// Out of range conversions to integers are errors.
+		return checkedCall(celHelperTypes[arg.typ]+"To"+celHelperTypes[typ], typ, arg), nil
+	}
+	return &celExpression{
+		typ:     typ,
+		prec:    precPrimary,
+		checked: arg.checked,
+		code:    func(self string) string { return goTypes[typ] + "(" + arg.code(self) + ")" },
+	}, nil
+}
+
+// parseSelection compiles a chain of field selections rooted at "self".
+func (p *celParser) parseSelection() (*celExpression, error) {
+	var names []string
+	for p.accept(".") {
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != celTokenIdent {
+			return nil, fmt.Errorf("expected field name after \"self.%s\"", strings.Join(names, "."))
+		}
+		names = append(names, p.tokens[p.pos].text)
+		p.pos++
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("self must be followed by a field selection")
+	}
+	return selectField(p.self, names, findJSONMember)
+}
+
+// selectField compiles the selection of a (possibly nested) field of self.
+// find resolves each name to the path of Go members leading to the field.
+func selectField(self *types.Type, names []string, find func(t *types.Type, name string) ([]types.Member, bool)) (*celExpression, error) {
+	t := self
+	var declared *types.Type
+	var path []string
+	for _, name := range names {
+		if t.Kind != types.Struct {
+			return nil, fmt.Errorf("cannot select %q from non-struct %v", name, t)
+		}
+		members, ok := find(t, name)
+		if !ok {
+			return nil, fmt.Errorf("%v has no field %q", t, name)
+		}
+		for _, m := range members {
+			path = append(path, m.Name)
+		}
+		declared = members[len(members)-1].Type
+		t = declared
+		for t.Kind == types.Alias {
+			t = t.Underlying
+		}
+		if t.Kind == types.Pointer {
+			return nil, fmt.Errorf("field %q is a pointer, which is not supported", name)
+		}
+	}
+	typ, ok := celTypeOf(t)
+	if !ok {
+		return nil, fmt.Errorf("field %s has unsupported type %v", strings.Join(path, "."), t)
+	}
+	selector := "." + strings.Join(path, ".")
+	// Scalar fields whose declared type is not exactly the Go type used to
+	// evaluate their CEL type (including aliases) are converted.
+	goType, scalar := goTypes[typ]
+	convert := scalar && (declared.Name.Package != "" || declared.Name.Name != goType)
+	return &celExpression{
+		typ:  typ,
+		prec: precPrimary,
+		code: func(self string) string {
+			if convert {
+				return goType + "(" + self + selector + ")"
+			}
+			return self + selector
+		},
+	}, nil
+}
+
+var fieldReferenceRE = regexp.MustCompile(`^\s*([A-Za-z_]\w*(?:\.[A-Za-z_]\w*)*)\s*(?:([+-])\s*(\d+))?\s*$`)
+
+// compileFieldDefault compiles a "+default:field=" reference to another field
+// of the struct declaring the defaulted field. The reference is a JSON field
+// name, as in CEL expressions, or a dotted path of them to select a field of a
+// nested struct, and numeric fields may be offset by an integer constant, e.g.
+// "port+1". Offsets are checked like CEL arithmetic, so that the default is not
+// applied if it overflows, or is negative for an unsigned field.
+func compileFieldDefault(ref string, self *types.Type) (*celExpression, error) {
+	matches := fieldReferenceRE.FindStringSubmatch(ref)
+	if matches == nil {
+		return nil, fmt.Errorf("expected a field name optionally followed by + or - and an integer")
+	}
+	field, err := selectField(self, strings.Split(matches[1], "."), findJSONMember)
+	if err != nil || matches[2] == "" {
+		return field, err
+	}
+	if field.typ != celInt && field.typ != celUint {
+		return nil, fmt.Errorf("an offset cannot be applied to a field of type %s", field.typ)
+	}
+	op, offset := matches[2], matches[3]
+	if _, err := strconv.ParseUint(offset, 10, 63); err != nil {
+		return nil, fmt.Errorf("invalid offset %s: %w", offset, err)
+	}
+	constant := &celExpression{
+		typ:  field.typ,
+		prec: precPrimary,
+		code: func(string) string { return offset },
+	}
+	return checkedCall(checkedOperators[op]+celHelperTypes[field.typ], field.typ, field, constant), nil
+}
+
+// findJSONMember returns the path of Go members which must be followed to
+// reach the field serialized with the given JSON name, descending into
+// embedded structs which are inlined in JSON.
+func findJSONMember(t *types.Type, jsonName string) ([]types.Member, bool) {
+	for _, m := range t.Members {
+		tag := strings.Split(reflect.StructTag(m.Tags).Get("json"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+		if tag == "" && m.Embedded {
+			embedded := m.Type
+			for embedded.Kind == types.Alias {
+				embedded = embedded.Underlying
+			}
+			if embedded.Kind != types.Struct {
+				continue
+			}
+			if path, ok := findJSONMember(embedded, jsonName); ok {
+				return append([]types.Member{m}, path...), true
+			}
+			continue
+		}
+		if tag == "" {
+			tag = m.Name
+		}
+		if tag == jsonName {
+			return []types.Member{m}, true
+		}
+	}
+	return nil, false
+}
+
+// celHelperPrefix prefixes the names of the checked arithmetic helpers.
+const celHelperPrefix = "celDefault"
+
+// celHelpers are the checked arithmetic helpers called by the compiled
+// expressions. They clear ok on the integer overflows, divisions by zero and
+// out of range conversions which are errors in CEL, so that the default is
+// not applied rather than wrapped around or panicking.
+const celHelpers = `
+// The checked arithmetic of +default:cel expressions, which are not applied
+// if they overflow or divide by zero.
+func celDefaultAddInt64(l, r int64, ok *bool) int64 {
+	s := l + r
+	if (r > 0 && s < l) || (r < 0 && s > l) {
+		*ok = false
+	}
+	return s
+}
+
+func celDefaultSubInt64(l, r int64, ok *bool) int64 {
+	d := l - r
+	if (r > 0 && d > l) || (r < 0 && d < l) {
+		*ok = false
+	}
+	return d
+}
+
+func celDefaultMulInt64(l, r int64, ok *bool) int64 {
+	p := l * r
+	if (l == -1 && r == $.MinInt64|raw$) || (r == -1 && l == $.MinInt64|raw$) || (r != 0 && p/r != l) {
+		*ok = false
+	}
+	return p
+}
+
+func celDefaultDivInt64(l, r int64, ok *bool) int64 {
+	if r == 0 || (l == $.MinInt64|raw$ && r == -1) {
+		*ok = false
+		return 0
+	}
+	return l / r
+}
+
+func celDefaultRemInt64(l, r int64, ok *bool) int64 {
+	if r == 0 || (l == $.MinInt64|raw$ && r == -1) {
+		*ok = false
+		return 0
+	}
+	return l % r
+}
+
+func celDefaultNegInt64(v int64, ok *bool) int64 {
+	if v == $.MinInt64|raw$ {
+		*ok = false
+	}
+	return -v
+}
+
+func celDefaultAddUint64(l, r uint64, ok *bool) uint64 {
+	s, carry := $.Add64|raw$(l, r, 0)
+	if carry != 0 {
+		*ok = false
+	}
+	return s
+}
+
+func celDefaultSubUint64(l, r uint64, ok *bool) uint64 {
+	d, borrow := $.Sub64|raw$(l, r, 0)
+	if borrow != 0 {
+		*ok = false
+	}
+	return d
+}
+
+func celDefaultMulUint64(l, r uint64, ok *bool) uint64 {
+	hi, lo := $.Mul64|raw$(l, r)
+	if hi != 0 {
+		*ok = false
+	}
+	return lo
+}
+
+func celDefaultDivUint64(l, r uint64, ok *bool) uint64 {
+	if r == 0 {
+		*ok = false
+		return 0
+	}
+	return l / r
+}
+
+func celDefaultRemUint64(l, r uint64, ok *bool) uint64 {
+	if r == 0 {
+		*ok = false
+		return 0
+	}
+	return l % r
+}
+
+func celDefaultInt64ToUint64(v int64, ok *bool) uint64 {
+	if v < 0 {
+		*ok = false
+	}
+	return uint64(v)
+}
+
+func celDefaultUint64ToInt64(v uint64, ok *bool) int64 {
+	if v > $.MaxInt64|raw$ {
+		*ok = false
+	}
+	return int64(v)
+}
+
+func celDefaultFloat64ToInt64(v float64, ok *bool) int64 {
+	if !(v >= -0x1p63 && v < 0x1p63) {
+		*ok = false
+		return 0
+	}
+	return int64(v)
+}
+
+func celDefaultFloat64ToUint64(v float64, ok *bool) uint64 {
+	if !(v > -1 && v < 0x1p64) {
+		*ok = false
+		return 0
+	}
+	return uint64(v)
+}
+`
+
+// writeCELHelpers writes the checked arithmetic helpers, if any compiled
+// expression calls them.
+func (g *genDefaulter) writeCELHelpers(c *generator.Context, w io.Writer) error {
+	if !g.celHelpers {
+		return nil
+	}
+	sw := generator.NewSnippetWriter(w, c, "$", "$")
+	sw.Do(celHelpers, generator.Args{
+		"MinInt64": types.Ref("math", "MinInt64"),
+		"MaxInt64": types.Ref("math", "MaxInt64"),
+		"Add64":    types.Ref("math/bits", "Add64"),
+		"Sub64":    types.Ref("math/bits", "Sub64"),
+		"Mul64":    types.Ref("math/bits", "Mul64"),
+	})
+	return sw.Error()
+}
+
+// fieldRange returns the bounds of the values of the builtin integer type t
+// which an int64 or uint64 must be checked against before being assigned to
+// it, if any.
+func fieldRange(t *types.Type) (min, max *types.Type) {
+	switch t.Name.Name {
+	case "int":
+		return types.Ref("math", "MinInt"), types.Ref("math", "MaxInt")
+	case "int8":
+		return types.Ref("math", "MinInt8"), types.Ref("math", "MaxInt8")
+	case "int16":
+		return types.Ref("math", "MinInt16"), types.Ref("math", "MaxInt16")
+	case "int32":
+		return types.Ref("math", "MinInt32"), types.Ref("math", "MaxInt32")
+	case "uint":
+		return nil, types.Ref("math", "MaxUint")
+	case "uint8", "byte":
+		return nil, types.Ref("math", "MaxUint8")
+	case "uint16":
+		return nil, types.Ref("math", "MaxUint16")
+	case "uint32":
+		return nil, types.Ref("math", "MaxUint32")
+	}
+	return nil, nil
+}
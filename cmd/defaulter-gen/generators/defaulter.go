@@ -62,12 +62,44 @@ var typeZeroValue = map[string]interface{}{
 // These are the comment tags that carry parameters for defaulter generation.
 const tagName = "k8s:defaulter-gen"
 const inputTagName = "k8s:defaulter-gen-input"
+const externalTagName = "k8s:defaulter-gen-external"
 const defaultTagName = "default"
+const celDefaultTagName = "default:cel"
+const fieldDefaultTagName = "default:field"
+const functionDefaultTagName = "default:ref"
+const listMemberDefaultTagName = "default:listMember"
+const featureGateDefaultTagName = "default:featureGate"
+const discriminatorDefaultTagName = "default:discriminatorValue"
+const unionDiscriminatorTagName = "unionDiscriminator"
 
 func extractDefaultTag(comments []string) []string {
 	return gengo.ExtractCommentTags("+", comments)[defaultTagName]
 }
 
+func extractCELDefaultTag(comments []string) []string {
+	return gengo.ExtractCommentTags("+", comments)[celDefaultTagName]
+}
+
+func extractFieldDefaultTag(comments []string) []string {
+	return gengo.ExtractCommentTags("+", comments)[fieldDefaultTagName]
+}
+
+func extractDiscriminatorDefaultTag(comments []string) []string {
+	return gengo.ExtractCommentTags("+", comments)[discriminatorDefaultTagName]
+}
+
+func extractFunctionDefaultTag(comments []string) []string {
+	return gengo.ExtractCommentTags("+", comments)[functionDefaultTagName]
+}
+
+func extractListMemberDefaultTag(comments []string) []string {
+	return gengo.ExtractCommentTags("+", comments)[listMemberDefaultTagName]
+}
+
+func extractFeatureGateDefaultTag(comments []string) []string {
+	return gengo.ExtractCommentTags("+", comments)[featureGateDefaultTagName]
+}
+
 func extractTag(comments []string) []string {
 	return gengo.ExtractCommentTags("+", comments)[tagName]
 }
@@ -76,6 +108,22 @@ func extractInputTag(comments []string) []string {
 	return gengo.ExtractCommentTags("+", comments)[inputTagName]
 }
 
+// extractExternalTag returns the fully qualified names of the functions
+// declared at the package level to default external types, e.g.
+//
+//	// +k8s:defaulter-gen-external=k8s.io/kubernetes/pkg/apis/core/v1.SetDefaults_PodSpec
+func extractExternalTag(comments []string) []types.Name {
+	var names []types.Name
+	for _, value := range gengo.ExtractCommentTags("+", comments)[externalTagName] {
+		name := types.ParseFullyQualifiedName(value)
+		if len(name.Package) == 0 || len(name.Name) == 0 {
+			klog.Fatalf("Expected +%s=<package path>.<function>, got %q", externalTagName, value)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
 func checkTag(comments []string, require ...string) bool {
 	values := gengo.ExtractCommentTags("+", comments)[tagName]
 	if len(require) == 0 {
@@ -105,10 +153,11 @@ func objectDefaultFnNamer() *namer.NameStrategy {
 // NameSystems returns the name system used by the generators in this package.
 func NameSystems() namer.NameSystems {
 	return namer.NameSystems{
-		"public":          namer.NewPublicNamer(1),
-		"raw":             namer.NewRawNamer("", nil),
-		"defaultfn":       defaultFnNamer(),
-		"objectdefaultfn": objectDefaultFnNamer(),
+		"public":             namer.NewPublicNamer(1),
+		"raw":                namer.NewRawNamer("", nil),
+		"defaultfn":          defaultFnNamer(),
+		"objectdefaultfn":    objectDefaultFnNamer(),
+		"recordingdefaultfn": recordingDefaultFnNamer(),
 	}
 }
 
@@ -136,6 +185,40 @@ type defaults struct {
 // the underlying type being "Func".
 type defaulterFuncMap map[*types.Type]defaults
 
+// addExternalDefaultingFunctions adds the functions declared with
+// "+k8s:defaulter-gen-external" in the comments of pkg to manualMap, as the
+// object defaulters of their types if they are named SetObjectDefaults_*, and
+// as their base defaulters otherwise. This lets types embedding types of
+// other packages, whose defaulters are not otherwise known, call them.
+func addExternalDefaultingFunctions(context *generator.Context, pkg *types.Package, manualMap defaulterFuncMap) {
+	for _, name := range extractExternalTag(pkg.Comments) {
+		fnPkg, ok := context.Universe[name.Package]
+		if !ok || fnPkg.Functions[name.Name] == nil {
+			klog.Fatalf("+%s=%s: function not found", externalTagName, name)
+		}
+		f := fnPkg.Functions[name.Name]
+		if f.Underlying == nil || f.Underlying.Signature == nil {
+			klog.Fatalf("+%s=%s: function without signature", externalTagName, name)
+		}
+		signature := f.Underlying.Signature
+		if signature.Receiver != nil || len(signature.Parameters) != 1 || len(signature.Results) != 0 || signature.Parameters[0].Type.Kind != types.Pointer {
+			klog.Fatalf("+%s=%s: expected a function of the form func(*Type)", externalTagName, name)
+		}
+		key := signature.Parameters[0].Type.Elem
+		v := manualMap[key]
+		existing := &v.base
+		if strings.HasPrefix(name.Name, "SetObjectDefaults_") {
+			existing = &v.object
+		}
+		if *existing != nil && (*existing).Name != f.Name {
+			klog.Fatalf("+%s=%s: %v already has the defaulter %v", externalTagName, name, key, (*existing).Name)
+		}
+		*existing = f
+		manualMap[key] = v
+		klog.V(6).Infof("found external defaulter function for %s from %s", key.Name, f.Name)
+	}
+}
+
 // Returns all manually-defined defaulting functions in the package.
 func getManualDefaultingFunctions(context *generator.Context, pkg *types.Package, manualMap defaulterFuncMap) {
 	buffer := &bytes.Buffer{}
@@ -228,6 +311,7 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 	}
 
 	targets := []generator.Target{}
+	context.FileTypes[defaultsManifestFileType] = newDefaultsManifestFile()
 
 	// Accumulate pre-existing default functions.
 	// TODO: This is too ad-hoc.  We need a better way.
@@ -265,6 +349,11 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 		} else {
 			pkgToInput[i] = i
 		}
+
+		// Load the packages of the defaulters of external types.
+		for _, name := range extractExternalTag(pkg.Comments) {
+			inputPkgs = append(inputPkgs, name.Package)
+		}
 	}
 
 	// Make sure explicit peer-packages are added.
@@ -310,6 +399,9 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 			getManualDefaultingFunctions(context, context.Universe[pp], existingDefaulters)
 		}
 
+		// And add the defaulters declared for external types.
+		addExternalDefaultingFunctions(context, pkg, existingDefaulters)
+
 		typesWith := extractTag(pkg.Comments)
 		shouldCreateObjectDefaulterFn := func(t *types.Type) bool {
 			if defaults, ok := existingDefaulters[t]; ok && defaults.object != nil {
@@ -419,9 +511,16 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 				},
 
 				GeneratorsFunc: func(c *generator.Context) (generators []generator.Generator) {
-					return []generator.Generator{
-						NewGenDefaulter(args.OutputFile, typesPkg.Path, pkg.Path, existingDefaulters, newDefaulters, peerPkgs),
+					defaulter := NewGenDefaulter(args.OutputFile, typesPkg.Path, pkg.Path, existingDefaulters, newDefaulters, peerPkgs, args.DefaultsRecorder, args.KindDefaulters)
+					generators = []generator.Generator{defaulter}
+					// A test file without tests would be only a header.
+					if args.GoldenTestFile != "" && len(newDefaulters) > 0 {
+						generators = append(generators, NewGenDefaulterGoldenTests(args.GoldenTestFile, pkg.Path, defaulter.(*genDefaulter)))
+					}
+					if args.DefaultsManifestFile != "" {
+						generators = append(generators, NewGenDefaultsManifest(args.DefaultsManifestFile, typesPkg.Path))
 					}
+					return generators
 				},
 			})
 	}
@@ -496,14 +595,15 @@ func getPointerElementPath(t *types.Type) []*types.Type {
 	return path
 }
 
-// getNestedDefault returns the first default value when resolving alias types
-func getNestedDefault(t *types.Type) string {
+// getNestedDefault returns the first default value when resolving alias types,
+// and the package of the type declaring it.
+func getNestedDefault(t *types.Type) (string, string) {
 	var prev *types.Type
 	for prev != t {
 		prev = t
 		defaultMap := extractDefaultTag(t.CommentLines)
 		if len(defaultMap) == 1 && defaultMap[0] != "" {
-			return defaultMap[0]
+			return defaultMap[0], t.Name.Package
 		}
 		if t.Kind == types.Alias {
 			t = t.Underlying
@@ -511,7 +611,7 @@ func getNestedDefault(t *types.Type) string {
 			t = t.Elem
 		}
 	}
-	return ""
+	return "", ""
 }
 
 var refRE = regexp.MustCompile(`^ref\((?P<reference>[^"]+)\)$`)
@@ -537,7 +637,26 @@ func parseSymbolReference(s, sourcePackage string) (types.Name, bool) {
 	return name, true
 }
 
-func populateDefaultValue(node *callNode, t *types.Type, tags string, commentLines []string, commentPackage string) *callNode {
+var constantRE = regexp.MustCompile(`^(?:[^"\s()]+\.)?[A-Z][A-Za-z0-9_]*$`)
+
+// parseConstantName looks for strings naming an exported constant without
+// ref(), either as Ident or pkgpath.Ident. It must only be used on strings
+// which are not valid JSON.
+func parseConstantName(s, sourcePackage string) (types.Name, bool) {
+	if !constantRE.MatchString(s) {
+		return types.Name{}, false
+	}
+	name := types.ParseFullyQualifiedName(s)
+	if len(name.Package) == 0 {
+		name.Package = sourcePackage
+	}
+	return name, true
+}
+
+// populateDefaultValue fills in the default declared by the given comment
+// lines, if any. parent is the struct declaring the defaulted field, or nil
+// when defaulting the elements of a slice, array or map.
+func populateDefaultValue(node *callNode, t *types.Type, tags string, commentLines []string, commentPackage string, parent *types.Type) *callNode {
 	defaultMap := extractDefaultTag(commentLines)
 	var defaultString string
 	if len(defaultMap) == 1 {
@@ -547,23 +666,103 @@ func populateDefaultValue(node *callNode, t *types.Type, tags string, commentLin
 	}
 
 	baseT, depth := resolveTypeAndDepth(t)
-	if depth > 0 && defaultString == "" {
-		defaultString = getNestedDefault(t)
+
+	// Defaults computed from the struct declaring the field.
+	celMap := extractCELDefaultTag(commentLines)
+	fieldMap := extractFieldDefaultTag(commentLines)
+	if len(celMap)+len(fieldMap) > 0 {
+		if len(celMap)+len(fieldMap) > 1 || len(defaultMap) > 0 {
+			klog.Fatalf("Found more than one default tag for %v", t.Kind)
+		}
+		tagName, compile := celDefaultTagName, compileCELDefault
+		if len(fieldMap) > 0 {
+			tagName, compile = fieldDefaultTagName, compileFieldDefault
+		}
+		source := append(celMap, fieldMap...)[0]
+		if parent == nil {
+			klog.Fatalf("+%s is only supported on struct fields, found on %v", tagName, t)
+		}
+		expr, err := compile(source, parent)
+		if err != nil {
+			klog.Fatalf("Failed to compile +%s=%s for a field of %v: %v", tagName, source, parent, err)
+		}
+		if typ, ok := celTypeOf(baseT); !ok || typ != expr.typ {
+			klog.Fatalf("+%s=%s for a field of %v has type %s, which cannot be assigned to %v", tagName, source, parent, expr.typ, t)
+		}
+		if node == nil {
+			node = &callNode{}
+			node.markerOnly = true
+		}
+		node.defaultIsPrimitive = true
+		node.defaultType = baseT
+		node.defaultTopLevelType = t
+		node.defaultValue.Expression = expr
+		return node
+	}
+	// Defaults computed by calling a function at runtime.
+	if functionMap := extractFunctionDefaultTag(commentLines); len(functionMap) > 0 {
+		if len(functionMap) > 1 || len(defaultMap) > 0 {
+			klog.Fatalf("Found more than one default tag for %v", t.Kind)
+		}
+		if parent == nil {
+			klog.Fatalf("+%s is only supported on struct fields, found on %v", functionDefaultTagName, t)
+		}
+		if !baseT.IsPrimitive() && t.Kind != types.Pointer && t.Kind != types.Slice && t.Kind != types.Map {
+			klog.Fatalf("+%s=%s cannot default %v, which cannot be told apart from unset", functionDefaultTagName, functionMap[0], t)
+		}
+		function := types.ParseFullyQualifiedName(functionMap[0])
+		if len(function.Package) == 0 {
+			function.Package = parent.Name.Package
+		}
+		if node == nil {
+			node = &callNode{}
+			node.markerOnly = true
+		}
+		node.defaultIsPrimitive = baseT.IsPrimitive()
+		node.defaultType = baseT
+		node.defaultTopLevelType = t
+		node.defaultValue.Function = function
+		return node
+	}
+	// Fields without a default of their own inherit the default declared on
+	// their type, except for structs, which cannot be told apart from unset.
+	if defaultString == "" && (depth > 0 || baseT.Kind != types.Struct) {
+		if nested, pkg := getNestedDefault(t); nested != "" {
+			defaultString, commentPackage = nested, pkg
+		}
 	}
 
 	if len(defaultString) == 0 {
 		return node
 	}
 	var symbolReference types.Name
+	var isConstantName bool
 	var defaultValue interface{}
 	if id, ok := parseSymbolReference(defaultString, commentPackage); ok {
 		symbolReference = id
 		defaultString = ""
 	} else if err := json.Unmarshal([]byte(defaultString), &defaultValue); err != nil {
-		klog.Fatalf("Failed to unmarshal default: %v", err)
+		id, ok := parseConstantName(defaultString, commentPackage)
+		if !ok {
+			klog.Fatalf("Failed to unmarshal default: %v", err)
+		}
+		symbolReference, isConstantName = id, true
+		defaultString = ""
 	}
 
+	var typedLiteral *typedLiteral
 	if defaultValue != nil {
+		lit, err := parseTypedLiteral(baseT, defaultValue)
+		if err != nil {
+			klog.Fatalf("Invalid default %s for %v: %v", defaultString, t, err)
+		}
+		if lit != nil && depth > 1 {
+			klog.Fatalf("Defaults of %v are only supported on fields of the type or a pointer to it, found on %v", baseT, t)
+		}
+		typedLiteral = lit
+	}
+
+	if defaultValue != nil && typedLiteral == nil {
 		zero := typeZeroValue[t.String()]
 		if reflect.DeepEqual(defaultValue, zero) {
 			// If the default value annotation matches the default value for the type,
@@ -583,9 +782,183 @@ func populateDefaultValue(node *callNode, t *types.Type, tags string, commentLin
 	node.defaultTopLevelType = t
 	node.defaultValue.InlineConstant = defaultString
 	node.defaultValue.SymbolReference = symbolReference
+	node.defaultValue.IsConstantName = isConstantName
+	node.defaultValue.TypedLiteral = typedLiteral
 	return node
 }
 
+// populateFeatureGatedDefaults fills in the "+default:featureGate" defaults of
+// a field, of the form "<feature>=<default>", where the default is written as
+// for "+default". Feature-gated defaults apply before any other default of
+// the field, when the feature gate passed to the object defaulter reports
+// their feature as enabled.
+func populateFeatureGatedDefaults(node *callNode, t *types.Type, commentLines []string, commentPackage string, parent *types.Type) *callNode {
+	for _, value := range extractFeatureGateDefaultTag(commentLines) {
+		feature, defaultString, ok := strings.Cut(value, "=")
+		if !ok || feature == "" {
+			klog.Fatalf("Expected +%s=<feature>=<default>, got %q", featureGateDefaultTagName, value)
+		}
+		gated := populateDefaultValue(nil, t, "", []string{"+" + defaultTagName + "=" + defaultString}, commentPackage, parent)
+		if gated == nil {
+			// The default is the zero value.
+			continue
+		}
+		if node == nil {
+			node = &callNode{}
+			node.markerOnly = true
+		}
+		node.featureGatedDefaults = append(node.featureGatedDefaults, featureGatedDefault{feature: feature, node: gated})
+	}
+	return node
+}
+
+// populateDiscriminatorGuard restricts the defaults of a member of a
+// discriminated union to the values of the discriminator given by
+// "+default:discriminatorValue", e.g.
+//
+//	// +unionDiscriminator
+//	Type string
+//	// +default:discriminatorValue=TCP
+//	// +default={"port": 80}
+//	TCP *TCPSettings
+//
+// The discriminator is the field of parent tagged "+unionDiscriminator", whose
+// type must be a string. Only the defaults declared on the member are guarded,
+// not those of its own fields.
+func populateDiscriminatorGuard(node *callNode, parent *types.Type, field types.Member) *callNode {
+	values := extractDiscriminatorDefaultTag(field.CommentLines)
+	if len(values) == 0 {
+		return node
+	}
+	if node == nil || (node.defaultValue.IsEmpty() && len(node.featureGatedDefaults) == 0) {
+		klog.Fatalf("+%s on %s of %v requires a default", discriminatorDefaultTagName, field.Name, parent)
+	}
+	var discriminator *types.Member
+	for i, m := range parent.Members {
+		if _, ok := gengo.ExtractCommentTags("+", m.CommentLines)[unionDiscriminatorTagName]; ok {
+			if discriminator != nil {
+				klog.Fatalf("%v has more than one +%s field", parent, unionDiscriminatorTagName)
+			}
+			discriminator = &parent.Members[i]
+		}
+	}
+	if discriminator == nil {
+		klog.Fatalf("+%s on %s of %v requires a field tagged +%s", discriminatorDefaultTagName, field.Name, parent, unionDiscriminatorTagName)
+	}
+	if base, depth := resolveTypeAndDepth(discriminator.Type); depth > 0 || base != types.String {
+		klog.Fatalf("The +%s field %s of %v must be a string, got %v", unionDiscriminatorTagName, discriminator.Name, parent, discriminator.Type)
+	}
+	node.discriminator = &discriminatorGuard{field: discriminator.Name}
+	for _, value := range values {
+		node.discriminator.values = append(node.discriminator.values, strconv.Quote(value))
+	}
+	return node
+}
+
+// populateListMemberDefaults fills in the "+default:listMember" defaults of an
+// associative list (one with "+listType=map"). Each value is a JSON object
+// which selects the members whose "+listMapKey" fields have the given values,
+// and holds defaults for other primitive fields of those members, e.g.
+//
+//	// +listType=map
+//	// +listMapKey=name
+//	// +default:listMember={"name": "http", "port": 80}
+func populateListMemberDefaults(node *callNode, t *types.Type, commentLines []string) *callNode {
+	memberDefaults := extractListMemberDefaultTag(commentLines)
+	if len(memberDefaults) == 0 {
+		return node
+	}
+	tags := gengo.ExtractCommentTags("+", commentLines)
+	if !reflect.DeepEqual(tags["listType"], []string{"map"}) || len(tags["listMapKey"]) == 0 {
+		klog.Fatalf("+%s requires +listType=map and +listMapKey on %v", listMemberDefaultTagName, t)
+	}
+	listType, _ := resolveTypeAndDepth(t)
+	if listType.Kind != types.Slice || listType.Elem.Kind != types.Struct {
+		klog.Fatalf("+%s is only supported on lists of structs, found on %v", listMemberDefaultTagName, t)
+	}
+	memberType := listType.Elem
+
+	if node == nil {
+		node = &callNode{}
+		node.markerOnly = true
+	}
+	for _, memberDefault := range memberDefaults {
+		values := map[string]json.RawMessage{}
+		if err := json.Unmarshal([]byte(memberDefault), &values); err != nil {
+			klog.Fatalf("Failed to unmarshal list member default %s: %v", memberDefault, err)
+		}
+		var d listMemberDefault
+		isKey := map[string]bool{}
+		for _, key := range tags["listMapKey"] {
+			isKey[key] = true
+			value, ok := values[key]
+			if !ok {
+				klog.Fatalf("List member default %s does not set the list map key %q", memberDefault, key)
+			}
+			d.keys = append(d.keys, memberFieldLiteral(memberType, key, value))
+		}
+		// Follow the declaration order of the fields for a stable output.
+		for _, m := range memberType.Members {
+			jsonName := strings.Split(reflect.StructTag(m.Tags).Get("json"), ",")[0]
+			if jsonName == "" {
+				jsonName = m.Name
+			}
+			if value, ok := values[jsonName]; ok && !isKey[jsonName] {
+				d.values = append(d.values, memberFieldLiteral(memberType, jsonName, value))
+				delete(values, jsonName)
+			}
+		}
+		for key := range values {
+			if !isKey[key] {
+				klog.Fatalf("List member default %s sets unknown field %q of %v", memberDefault, key, memberType)
+			}
+		}
+		node.listMemberDefaults = append(node.listMemberDefaults, d)
+	}
+	return node
+}
+
+// memberFieldLiteral converts the JSON value of a primitive field of a list
+// member to a Go literal.
+func memberFieldLiteral(memberType *types.Type, jsonName string, value json.RawMessage) fieldLiteral {
+	members, ok := findJSONMember(memberType, jsonName)
+	if !ok {
+		klog.Fatalf("%v has no field %q", memberType, jsonName)
+	}
+	fieldType := members[len(members)-1].Type
+	for fieldType.Kind == types.Alias {
+		fieldType = fieldType.Underlying
+	}
+	if !fieldType.IsPrimitive() {
+		klog.Fatalf("List member defaults are only supported on primitive fields, %q of %v is %v", jsonName, memberType, fieldType)
+	}
+	var literal interface{}
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&literal); err != nil {
+		klog.Fatalf("Failed to unmarshal list member default for %q: %v", jsonName, err)
+	}
+	zero, err := getTypeZeroValue(fieldType.String())
+	if err != nil {
+		klog.Fatal(err)
+	}
+	var path []string
+	for _, m := range members {
+		path = append(path, m.Name)
+	}
+	l := fieldLiteral{path: strings.Join(path, "."), jsonName: jsonName, zero: fmt.Sprint(zero)}
+	switch v := literal.(type) {
+	case string:
+		l.value = strconv.Quote(v)
+	case bool, json.Number:
+		l.value = fmt.Sprint(v)
+	}
+	if _, isString := literal.(string); l.value == "" || isString != (fieldType.Name.Name == "string") {
+		klog.Fatalf("List member default %s cannot be assigned to %q of %v", value, jsonName, memberType)
+	}
+	return l
+}
+
 // build creates a tree of paths to fields (based on how they would be accessed in Go - pointer, elem,
 // slice, or key) and the functions that should be invoked on each field. An in-order traversal of the resulting tree
 // can be used to generate a Go function that invokes each nested function on the appropriate type. The return
@@ -658,15 +1031,18 @@ func (c *callTreeForType) build(t *types.Type, root bool) *callNode {
 				child.elem = true
 			}
 			parent.children = append(parent.children, *child)
-		} else if member := populateDefaultValue(nil, t.Elem, "", t.Elem.CommentLines, t.Elem.Name.Package); member != nil {
+		} else if member := populateDefaultValue(nil, t.Elem, "", t.Elem.CommentLines, t.Elem.Name.Package, nil); member != nil {
 			member.index = true
 			parent.children = append(parent.children, *member)
 		}
 	case types.Map:
 		if child := c.build(t.Elem, false); child != nil {
 			child.key = true
+			if t.Elem.Kind == types.Pointer {
+				child.elem = true
+			}
 			parent.children = append(parent.children, *child)
-		} else if member := populateDefaultValue(nil, t.Elem, "", t.Elem.CommentLines, t.Elem.Name.Package); member != nil {
+		} else if member := populateDefaultValue(nil, t.Elem, "", t.Elem.CommentLines, t.Elem.Name.Package, nil); member != nil {
 			member.key = true
 			parent.children = append(parent.children, *member)
 		}
@@ -681,15 +1057,22 @@ func (c *callTreeForType) build(t *types.Type, root bool) *callNode {
 					name = field.Type.Name.Name
 				}
 			}
-			if child := c.build(field.Type, false); child != nil {
+			child := c.build(field.Type, false)
+			commentPackage := t.Name.Package
+			if child != nil {
+				commentPackage = field.Type.Name.Package
+			}
+			child = populateDefaultValue(child, field.Type, field.Tags, field.CommentLines, commentPackage, t)
+			child = populateFeatureGatedDefaults(child, field.Type, field.CommentLines, commentPackage, t)
+			child = populateListMemberDefaults(child, field.Type, field.CommentLines)
+			child = populateDiscriminatorGuard(child, t, field)
+			if child != nil {
 				child.field = name
-				populateDefaultValue(child, field.Type, field.Tags, field.CommentLines, field.Type.Name.Package)
+				child.jsonName = jsonMemberName(field)
 				parent.children = append(parent.children, *child)
-			} else if member := populateDefaultValue(nil, field.Type, field.Tags, field.CommentLines, t.Name.Package); member != nil {
-				member.field = name
-				parent.children = append(parent.children, *member)
 			}
 		}
+		parent.children = defaultDiscriminatorFirst(parent.children)
 	case types.Alias:
 		if child := c.build(t.Underlying, false); child != nil {
 			parent.children = append(parent.children, *child)
@@ -702,6 +1085,28 @@ func (c *callTreeForType) build(t *types.Type, root bool) *callNode {
 	return parent
 }
 
+// defaultDiscriminatorFirst moves the node of the discriminator of a union
+// before the first member guarded by it, if declared after it, so that the
+// guards observe the default of the discriminator wherever it is declared.
+func defaultDiscriminatorFirst(children []callNode) []callNode {
+	for i := range children {
+		guard := children[i].discriminator
+		if guard == nil {
+			continue
+		}
+		for j := i + 1; j < len(children); j++ {
+			if children[j].field == guard.field {
+				discriminator := children[j]
+				copy(children[i+1:j+1], children[i:j])
+				children[i] = discriminator
+				break
+			}
+		}
+		return children
+	}
+	return children
+}
+
 const (
 	runtimePackagePath    = "k8s.io/apimachinery/pkg/runtime"
 	conversionPackagePath = "k8s.io/apimachinery/pkg/conversion"
@@ -717,9 +1122,20 @@ type genDefaulter struct {
 	existingDefaulters defaulterFuncMap
 	imports            namer.ImportTracker
 	typesForInit       []*types.Type
+	// recordDefaults is true to also generate the recording variants of the
+	// object defaulters.
+	recordDefaults bool
+	// kindDefaulters is true to also generate a map of the object defaulters
+	// by GroupVersionKind.
+	kindDefaulters bool
+	// typedLiterals are the defaults stored in package-level variables.
+	typedLiterals []*typedLiteral
+	// celHelpers is true if a compiled expression calls the checked
+	// arithmetic helpers.
+	celHelpers bool
 }
 
-func NewGenDefaulter(outputFilename, typesPackage, outputPackage string, existingDefaulters, newDefaulters defaulterFuncMap, peerPkgs []string) generator.Generator {
+func NewGenDefaulter(outputFilename, typesPackage, outputPackage string, existingDefaulters, newDefaulters defaulterFuncMap, peerPkgs []string, recordDefaults, kindDefaulters bool) generator.Generator {
 	return &genDefaulter{
 		GoGenerator: generator.GoGenerator{
 			OutputFilename: outputFilename,
@@ -731,6 +1147,8 @@ func NewGenDefaulter(outputFilename, typesPackage, outputPackage string, existin
 		existingDefaulters: existingDefaulters,
 		imports:            generator.NewImportTrackerForPackage(outputPackage),
 		typesForInit:       make([]*types.Type, 0),
+		recordDefaults:     recordDefaults,
+		kindDefaulters:     kindDefaulters,
 	}
 }
 
@@ -778,6 +1196,12 @@ func (g *genDefaulter) Init(c *generator.Context, w io.Writer) error {
 		Kind: types.Pointer,
 		Elem: scheme,
 	}
+	usesFeatureGate := false
+	for _, t := range g.typesForInit {
+		callTree := newCallTreeForType(g.existingDefaulters, g.newDefaulters).build(t, true)
+		usesFeatureGate = usesFeatureGate || (callTree != nil && callTree.usesFeatureGate())
+	}
+
 	sw.Do("// RegisterDefaults adds defaulters functions to the given scheme.\n", nil)
 	sw.Do("// Public to allow building arbitrary schemes.\n", nil)
 	sw.Do("// All generated defaulters are covering - they call all nested defaulters.\n", nil)
@@ -788,6 +1212,15 @@ func (g *genDefaulter) Init(c *generator.Context, w io.Writer) error {
 	}
 	sw.Do("return nil\n", nil)
 	sw.Do("}\n\n", nil)
+	if usesFeatureGate {
+		sw.Do(featureGate, nil)
+	}
+	if g.kindDefaulters && len(g.typesForInit) > 0 {
+		g.writeKindDefaulters(c, sw)
+	}
+	if g.recordDefaults && len(g.typesForInit) > 0 {
+		writeDefaultsRecorder(sw)
+	}
 	return sw.Error()
 }
 
@@ -805,13 +1238,18 @@ func (g *genDefaulter) GenerateType(c *generator.Context, t *types.Type, w io.Wr
 	}
 	i := 0
 	callTree.VisitInOrder(func(ancestors []*callNode, current *callNode) {
-		if ref := &current.defaultValue.SymbolReference; len(ref.Name) > 0 {
-			// Ensure package for symbol is imported in output generation
-			g.imports.AddSymbol(*ref)
-
-			// Rewrite the fully qualified name using the local package name
-			// from the imports
-			ref.Package = g.imports.LocalNameOf(ref.Package)
+		if len(current.defaultValue.Function.Name) > 0 {
+			checkDefaultFunction(c.Universe, current)
+		}
+		checkDefaultConstant(c.Universe, &current.defaultValue)
+		g.importSymbolReference(&current.defaultValue)
+		g.declareTypedLiteral(&current.defaultValue)
+		g.declareCELHelpers(&current.defaultValue)
+		for _, gated := range current.featureGatedDefaults {
+			checkDefaultConstant(c.Universe, &gated.node.defaultValue)
+			g.importSymbolReference(&gated.node.defaultValue)
+			g.declareTypedLiteral(&gated.node.defaultValue)
+			g.declareCELHelpers(&gated.node.defaultValue)
 		}
 
 		if len(current.call) == 0 {
@@ -824,9 +1262,85 @@ func (g *genDefaulter) GenerateType(c *generator.Context, t *types.Type, w io.Wr
 
 	sw := generator.NewSnippetWriter(w, c, "$", "$")
 	g.generateDefaulter(c, t, callTree, sw)
+	if g.recordDefaults {
+		g.generateRecordingDefaulter(c, t, callTree, sw)
+	}
 	return sw.Error()
 }
 
+func (g *genDefaulter) Finalize(c *generator.Context, w io.Writer) error {
+	if err := g.writeTypedLiterals(c, w); err != nil {
+		return err
+	}
+	return g.writeCELHelpers(c, w)
+}
+
+// declareCELHelpers notes that the checked arithmetic helpers must be written
+// if the expression of d calls them.
+func (g *genDefaulter) declareCELHelpers(d *defaultValue) {
+	if d.Expression != nil && d.Expression.checked {
+		g.celHelpers = true
+	}
+}
+
+// importSymbolReference imports the package of the symbol or function
+// referenced by a default, if any, and rewrites the reference to use the
+// local package name.
+func (g *genDefaulter) importSymbolReference(d *defaultValue) {
+	for _, ref := range []*types.Name{&d.SymbolReference, &d.Function} {
+		if len(ref.Name) > 0 {
+			// Ensure package for symbol is imported in output generation
+			g.imports.AddSymbol(*ref)
+
+			// Rewrite the fully qualified name using the local package name
+			// from the imports
+			ref.Package = g.imports.LocalNameOf(ref.Package)
+		}
+	}
+}
+
+// checkDefaultConstant verifies that a default naming a constant without
+// ref() names a constant which is known, so that misspelled literals are
+// reported when generating rather than compiling the defaulters.
+func checkDefaultConstant(u types.Universe, d *defaultValue) {
+	if !d.IsConstantName {
+		return
+	}
+	name := d.SymbolReference
+	if pkg, ok := u[name.Package]; !ok || pkg.Constants[name.Name] == nil {
+		klog.Fatalf("Default %s is neither valid JSON nor a known constant, constants of other packages must be in an input or peer package", name)
+	}
+}
+
+// checkDefaultFunction verifies that the function referenced by the
+// "+default:ref" default of node exists, takes no arguments and returns a
+// single value of the type of the field, or, for primitive fields and
+// pointers to them, of a type with the same underlying primitive type.
+func checkDefaultFunction(u types.Universe, node *callNode) {
+	name := node.defaultValue.Function
+	pkg, ok := u[name.Package]
+	if !ok || pkg.Functions[name.Name] == nil {
+		klog.Fatalf("+%s=%s: function not found, functions of other packages must be in an input or peer package", functionDefaultTagName, name)
+	}
+	fn := pkg.Functions[name.Name]
+	sig := fn.Signature
+	if sig == nil && fn.Underlying != nil {
+		sig = fn.Underlying.Signature
+	}
+	if sig == nil || sig.Receiver != nil || len(sig.Parameters) != 0 || sig.Variadic || len(sig.Results) != 1 {
+		klog.Fatalf("+%s=%s: expected a function without arguments returning a single value", functionDefaultTagName, name)
+	}
+	result := sig.Results[0].Type
+	if node.defaultIsPrimitive {
+		if base, depth := resolveTypeAndDepth(result); depth == 0 && base == node.defaultType {
+			return
+		}
+	} else if result == node.defaultTopLevelType {
+		return
+	}
+	klog.Fatalf("+%s=%s: returns %v, which cannot be assigned to %v", functionDefaultTagName, name, result, node.defaultTopLevelType)
+}
+
 func defaultingArgsFromType(inType *types.Type) generator.Args {
 	return generator.Args{
 		"inType": inType,
@@ -835,7 +1349,24 @@ func defaultingArgsFromType(inType *types.Type) generator.Args {
 
 func (g *genDefaulter) generateDefaulter(c *generator.Context, inType *types.Type, callTree *callNode, sw *generator.SnippetWriter) {
 	sw.Do("func $.inType|objectdefaultfn$(in *$.inType|raw$) {\n", defaultingArgsFromType(inType))
-	callTree.WriteMethod(c, "in", 0, nil, sw)
+	callTree.WriteMethod(c, "in", 0, nil, nil, sw)
+	sw.Do("}\n\n", nil)
+}
+
+// generateRecordingDefaulter writes the variant of the object defaulter of
+// inType which reports the defaults it applies to a DefaultsRecorder.
+func (g *genDefaulter) generateRecordingDefaulter(c *generator.Context, inType *types.Type, callTree *callNode, sw *generator.SnippetWriter) {
+	recording := &defaultsRecording{recorders: map[*types.Type]*types.Type{}}
+	for t, d := range g.newDefaulters {
+		if d.object != nil {
+			recording.recorders[d.object] = t
+		}
+	}
+	args := defaultingArgsFromType(inType)
+	sw.Do("// $.inType|recordingdefaultfn$ is like $.inType|objectdefaultfn$,\n", args)
+	sw.Do("// but reports the paths of the fields defaulted by markers to recorder.\n", args)
+	sw.Do("func $.inType|recordingdefaultfn$(in *$.inType|raw$, recorder DefaultsRecorder) {\n", args)
+	callTree.WriteMethod(c, "in", 0, nil, recording, sw)
 	sw.Do("}\n\n", nil)
 }
 
@@ -870,6 +1401,8 @@ func (g *genDefaulter) generateDefaulter(c *generator.Context, inType *types.Typ
 type callNode struct {
 	// field is the name of the Go member to access
 	field string
+	// jsonName is the JSON name of the member, empty for inlined members
+	jsonName string
 	// key is true if this is a map and we must range over the key and values
 	key bool
 	// index is true if this is a slice and we must range over the slice values
@@ -906,6 +1439,53 @@ type callNode struct {
 	// defaultTopLevelType is the final type the value should resolve to
 	// This is in constrast with default type, which resolves aliases and pointers.
 	defaultTopLevelType *types.Type
+
+	// listMemberDefaults are the defaults of the members of an associative
+	// list which are selected by the values of their keys.
+	listMemberDefaults []listMemberDefault
+
+	// featureGatedDefaults are the defaults which only apply when a feature
+	// is enabled, in order of precedence.
+	featureGatedDefaults []featureGatedDefault
+
+	// discriminator restricts the defaults of a member of a discriminated
+	// union to some values of the discriminator, if set.
+	discriminator *discriminatorGuard
+}
+
+// discriminatorGuard is the condition under which the defaults of a member
+// of a discriminated union apply.
+type discriminatorGuard struct {
+	// field is the Go name of the discriminator in the struct declaring the
+	// member
+	field string
+	// values are the Go literals of the values of the discriminator
+	values []string
+}
+
+// featureGatedDefault is a default which applies when a feature is enabled.
+type featureGatedDefault struct {
+	feature string
+	// node holds the default value
+	node *callNode
+}
+
+// listMemberDefault holds defaults for the members of an associative list
+// whose key fields have the given values.
+type listMemberDefault struct {
+	keys   []fieldLiteral
+	values []fieldLiteral
+}
+
+// fieldLiteral is a literal value of a primitive field of a list member.
+type fieldLiteral struct {
+	// path is the Go selector of the field, relative to the member
+	path string
+	// jsonName is the JSON name of the field
+	jsonName string
+	// value and zero are the Go literals of the value and the zero value
+	value string
+	zero  string
 }
 
 type defaultValue struct {
@@ -916,17 +1496,32 @@ type defaultValue struct {
 	// i.e. k8s.io/pkg.apis.v1.Foo if from another package or simply `Foo`
 	// if within the same package.
 	SymbolReference types.Name
+	// IsConstantName is true if SymbolReference was written without ref(),
+	// in which case it must name a known constant.
+	IsConstantName bool
+	// A "+default:cel" expression or "+default:field" reference computing
+	// the value from the struct declaring the field.
+	Expression *celExpression
+	// The function referenced by "+default:ref", called without arguments
+	// to compute the value.
+	Function types.Name
+	// The value of a Quantity, Duration or Time, parsed from InlineConstant
+	// and stored in a package-level variable.
+	TypedLiteral *typedLiteral
 }
 
 func (d defaultValue) IsEmpty() bool {
 	resolved := d.Resolved()
-	return resolved == ""
+	return resolved == "" && d.Expression == nil
 }
 
 func (d defaultValue) Resolved() string {
 	if len(d.InlineConstant) > 0 {
 		return d.InlineConstant
 	}
+	if len(d.Function.Name) > 0 {
+		return d.Function.String() + "()"
+	}
 	return d.SymbolReference.String()
 }
 
@@ -970,16 +1565,75 @@ func varsForDepth(depth int) (index, local string) {
 
 // writeCalls generates a list of function calls based on the calls field for the provided variable
 // name and pointer.
-func (n *callNode) writeCalls(varName string, isVarPointer bool, sw *generator.SnippetWriter) {
+func (n *callNode) writeCalls(varName string, isVarPointer bool, recording *defaultsRecording, sw *generator.SnippetWriter) {
 	accessor := varName
 	if !isVarPointer {
 		accessor = "&" + accessor
 	}
 	for _, fn := range n.call {
-		sw.Do("$.fn|raw$($.var$)\n", generator.Args{
+		args := generator.Args{
 			"fn":  fn,
 			"var": accessor,
-		})
+		}
+		if t := recording.recorderOf(fn); t != nil {
+			// Call the recording variant of nested object defaulters.
+			args := recording.snippetArgs().WithArgs(args).With("inType", t)
+			sw.Do("$.inType|recordingdefaultfn$($.var$, "+recording.recorder()+")\n", args)
+			continue
+		}
+		sw.Do("$.fn|raw$($.var$)\n", args)
+	}
+}
+
+// usesFeatureGate returns true if the call tree applies feature-gated
+// defaults.
+func (n *callNode) usesFeatureGate() bool {
+	uses := false
+	n.VisitInOrder(func(ancestors []*callNode, node *callNode) {
+		uses = uses || len(node.featureGatedDefaults) > 0
+	})
+	return uses
+}
+
+// featureGate declares the feature gate of the package, which the defaulters
+// consult for the defaults which only apply when their feature is enabled.
+// Its parameter is generic in the type of the features, so that it is
+// satisfied by k8s.io/component-base/featuregate.FeatureGate without
+// importing it.
+const featureGate = `// FeatureGate reports whether a feature is enabled. It is satisfied by
+// k8s.io/component-base/featuregate.FeatureGate.
+type FeatureGate[F ~string] interface {
+	Enabled(feature F) bool
+}
+
+// featureEnabled reports whether a feature is enabled in the feature gate set
+// by SetFeatureGate, if any.
+var featureEnabled func(feature string) bool
+
+// SetFeatureGate sets the feature gate consulted by the defaulters of this
+// package for the defaults which only apply when their feature is enabled.
+// Until it is set, or when set to nil, these defaults do not apply. It is not
+// safe to call concurrently with the defaulters.
+func SetFeatureGate[F ~string](features FeatureGate[F]) {
+	if features == nil {
+		featureEnabled = nil
+		return
+	}
+	featureEnabled = func(feature string) bool {
+		return features.Enabled(F(feature))
+	}
+}
+
+`
+
+// writeFeatureGatedDefaults generates the defaults which apply when their
+// feature is enabled in the feature gate set for the package.
+func (n *callNode) writeFeatureGatedDefaults(c *generator.Context, varName string, index string, isVarPointer bool, recording *defaultsRecording, sw *generator.SnippetWriter) {
+	for _, gated := range n.featureGatedDefaults {
+		sw.Do("if featureEnabled != nil && featureEnabled($.$) {\n", strconv.Quote(gated.feature))
+		gated.node.field = n.field
+		gated.node.writeDefaulter(c, varName, index, isVarPointer, recording, sw)
+		sw.Do("}\n", nil)
 	}
 }
 
@@ -996,7 +1650,7 @@ func getTypeZeroValue(t string) (interface{}, error) {
 	return defaultZero, nil
 }
 
-func (n *callNode) writeDefaulter(c *generator.Context, varName string, index string, isVarPointer bool, sw *generator.SnippetWriter) {
+func (n *callNode) writeDefaulter(c *generator.Context, varName string, index string, isVarPointer bool, recording *defaultsRecording, sw *generator.SnippetWriter) {
 	if n.defaultValue.IsEmpty() {
 		return
 	}
@@ -1010,6 +1664,31 @@ func (n *callNode) writeDefaulter(c *generator.Context, varName string, index st
 		"varTopType":    n.defaultTopLevelType,
 		"jsonUnmarshal": jsonUnmarshalType,
 	}
+	guard := ""
+	if expr := n.defaultValue.Expression; expr != nil {
+		// Expressions are evaluated against the struct holding the field.
+		args["defaultValue"] = expr.code(strings.TrimSuffix(varName, "."+n.field))
+		var min, max *types.Type
+		if expr.typ == celInt || expr.typ == celUint {
+			min, max = fieldRange(n.defaultType)
+		}
+		if expr.checked || max != nil {
+			// Expressions which overflow, divide by zero or do not fit in
+			// the field are not applied.
+			guard = "if value, ok := func() (value $.goType$, ok bool) {\nok = true\nvalue = $.expression$\nreturn value, ok"
+			if min != nil {
+				guard += " && $.min|raw$ <= value"
+			}
+			if max != nil {
+				guard += " && value <= $.max|raw$"
+			}
+			guard += "\n}(); ok {\n"
+			args["goType"] = goTypes[expr.typ]
+			args["expression"] = args["defaultValue"]
+			args["min"], args["max"] = min, max
+			args["defaultValue"] = "value"
+		}
+	}
 
 	variablePlaceholder := ""
 
@@ -1029,7 +1708,25 @@ func (n *callNode) writeDefaulter(c *generator.Context, varName string, index st
 	// defaultIsPrimitive is true if the type or underlying type (in an array/map) is primitive
 	// or is a pointer to a primitive type
 	// (Eg: int, map[string]*string, []int)
-	if n.defaultIsPrimitive {
+	if lit := n.defaultValue.TypedLiteral; lit != nil {
+		// Typed literals are copied from their package-level variable.
+		literalArgs := args.WithArgs(generator.Args{
+			"literal": lit.varName,
+			"type":    lit.t,
+		})
+		value := "$.literal$"
+		if lit.deepCopy {
+			value = "$.literal$.DeepCopy()"
+		}
+		if n.defaultTopLevelType.Kind == types.Pointer {
+			sw.Do(fmt.Sprintf("if %s == nil {\n", variablePlaceholder), literalArgs)
+			sw.Do("ptrVar1 := "+value+"\n", literalArgs)
+			sw.Do(fmt.Sprintf("%s = &ptrVar1\n", variablePlaceholder), literalArgs)
+		} else {
+			sw.Do(fmt.Sprintf("if %s == ($.type|raw${}) {\n", variablePlaceholder), literalArgs)
+			sw.Do(fmt.Sprintf("%s = %s\n", variablePlaceholder, value), literalArgs)
+		}
+	} else if n.defaultIsPrimitive {
 		// If the default value is a primitive when the assigned type is a pointer
 		// keep using the address-of operator on the primitive value until the types match
 		if pointerPath := getPointerElementPath(n.defaultTopLevelType); len(pointerPath) > 0 {
@@ -1043,6 +1740,7 @@ func (n *callNode) writeDefaulter(c *generator.Context, varName string, index st
 			})
 
 			sw.Do(fmt.Sprintf("if %s == nil {\n", variablePlaceholder), pointerArgs)
+			sw.Do(guard, args)
 			if len(n.defaultValue.InlineConstant) > 0 {
 				// If default value is a literal then it can be assigned via var stmt
 				sw.Do("var ptrVar$.varDepth$ $.baseElemType|raw$ = $.defaultValue$\n", pointerArgs)
@@ -1092,13 +1790,19 @@ func (n *callNode) writeDefaulter(c *generator.Context, varName string, index st
 			args["defaultZero"] = defaultZero
 
 			sw.Do(fmt.Sprintf("if %s == $.defaultZero$ {\n", variablePlaceholder), args)
+			sw.Do(guard, args)
 
 			if len(n.defaultValue.InlineConstant) > 0 {
-				sw.Do(fmt.Sprintf("%s = $.defaultValue$", variablePlaceholder), args)
+				sw.Do(fmt.Sprintf("%s = $.defaultValue$\n", variablePlaceholder), args)
 			} else {
-				sw.Do(fmt.Sprintf("%s = $.varTopType|raw$($.defaultValue$)", variablePlaceholder), args)
+				sw.Do(fmt.Sprintf("%s = $.varTopType|raw$($.defaultValue$)\n", variablePlaceholder), args)
 			}
 		}
+	} else if len(n.defaultValue.Function.Name) > 0 {
+		// The function returns the type of the field, which has been checked
+		// to be nillable.
+		sw.Do(fmt.Sprintf("if %s == nil {\n", variablePlaceholder), args)
+		sw.Do(fmt.Sprintf("%s = $.defaultValue$\n", variablePlaceholder), args)
 	} else {
 		sw.Do(fmt.Sprintf("if %s == nil {\n", variablePlaceholder), args)
 		// Map values are not directly addressable and we need a temporary variable to do json unmarshalling
@@ -1119,19 +1823,57 @@ func (n *callNode) writeDefaulter(c *generator.Context, varName string, index st
 			sw.Do("$.varName$[$.index$] = $.mapDefaultVar$\n", args)
 		}
 	}
+	recording.writeRecord(sw)
+	if guard != "" {
+		sw.Do("}\n", nil)
+	}
+	sw.Do("}\n", nil)
+}
+
+// writeListMemberDefaults generates a loop filling in the defaults of the
+// associative list members selected by their keys.
+func (n *callNode) writeListMemberDefaults(varName string, index string, recording *defaultsRecording, sw *generator.SnippetWriter) {
+	if len(n.listMemberDefaults) == 0 {
+		return
+	}
+	args := generator.Args{
+		"var":   varName,
+		"index": index,
+	}
+	sw.Do("for $.index$ := range $.var$ {\n", args)
+	for _, d := range n.listMemberDefaults {
+		var conditions []string
+		for _, key := range d.keys {
+			conditions = append(conditions, fmt.Sprintf("%s[%s].%s == %s", varName, index, key.path, key.value))
+		}
+		sw.Do("if $.conditions$ {\n", generator.Args{"conditions": strings.Join(conditions, " && ")})
+		for _, value := range d.values {
+			valueArgs := generator.Args{
+				"field": fmt.Sprintf("%s[%s].%s", varName, index, value.path),
+				"value": value.value,
+				"zero":  value.zero,
+			}
+			sw.Do("if $.field$ == $.zero$ {\n", valueArgs)
+			sw.Do("$.field$ = $.value$\n", valueArgs)
+			recording.index(index, false).field(value.jsonName).writeRecord(sw)
+			sw.Do("}\n", nil)
+		}
+		sw.Do("}\n", nil)
+	}
 	sw.Do("}\n", nil)
 }
 
 // WriteMethod performs an in-order traversal of the calltree, generating loops and if blocks as necessary
 // to correctly turn the call tree into a method body that invokes all calls on all child nodes of the call tree.
 // Depth is used to generate local variables at the proper depth.
-func (n *callNode) WriteMethod(c *generator.Context, varName string, depth int, ancestors []*callNode, sw *generator.SnippetWriter) {
+func (n *callNode) WriteMethod(c *generator.Context, varName string, depth int, ancestors []*callNode, recording *defaultsRecording, sw *generator.SnippetWriter) {
 	// if len(n.call) > 0 {
 	// 	sw.Do(fmt.Sprintf("// %s\n", callPath(append(ancestors, n)).String()), nil)
 	// }
 
 	if len(n.field) > 0 {
 		varName = varName + "." + n.field
+		recording = recording.field(n.jsonName)
 	}
 
 	index, local := varsForDepth(depth)
@@ -1141,7 +1883,7 @@ func (n *callNode) WriteMethod(c *generator.Context, varName string, depth int,
 		"var":   varName,
 	}
 
-	isPointer := n.elem && !n.index
+	isPointer := n.elem && !n.index && !n.key
 	if isPointer && len(ancestors) > 0 {
 		sw.Do("if $.var$ != nil {\n", vars)
 	}
@@ -1157,26 +1899,55 @@ func (n *callNode) WriteMethod(c *generator.Context, varName string, depth int,
 			}
 		}
 
-		n.writeDefaulter(c, varName, index, isPointer, sw)
-		n.writeCalls(local, true, sw)
+		recording := recording.index(index, false)
+		n.writeDefaulter(c, varName, index, isPointer, recording, sw)
+		n.writeCalls(local, true, recording, sw)
 		for i := range n.children {
-			n.children[i].WriteMethod(c, local, depth+1, append(ancestors, n), sw)
+			n.children[i].WriteMethod(c, local, depth+1, append(ancestors, n), recording, sw)
 		}
 		sw.Do("}\n", nil)
 	case n.key:
-		if !n.defaultValue.IsEmpty() {
+		hasValueDefaulters := len(n.call) > 0 || len(n.children) > 0
+		if !n.defaultValue.IsEmpty() || hasValueDefaulters {
 			// Map keys are typed and cannot share the same index variable as arrays and other maps
 			index = index + "_" + ancestors[len(ancestors)-1].field
 			vars["index"] = index
 			sw.Do("for $.index$ := range $.var$ {\n", vars)
-			n.writeDefaulter(c, varName, index, isPointer, sw)
+			recording := recording.index(index, true)
+			n.writeDefaulter(c, varName, index, isPointer, recording, sw)
+			if hasValueDefaulters {
+				// Map values are not addressable, so values other than pointers
+				// are defaulted in a copy which is then stored back.
+				sw.Do("$.local$ := $.var$[$.index$]\n", vars)
+				n.writeCalls(local, n.elem, recording, sw)
+				for i := range n.children {
+					n.children[i].WriteMethod(c, local, depth+1, append(ancestors, n), recording, sw)
+				}
+				if !n.elem {
+					sw.Do("$.var$[$.index$] = $.local$\n", vars)
+				}
+			}
 			sw.Do("}\n", nil)
 		}
 	default:
-		n.writeDefaulter(c, varName, index, isPointer, sw)
-		n.writeCalls(varName, isPointer, sw)
+		if guard := n.discriminator; guard != nil {
+			// The discriminator is a sibling of the member.
+			discriminator := strings.TrimSuffix(varName, "."+n.field) + "." + guard.field
+			var conditions []string
+			for _, value := range guard.values {
+				conditions = append(conditions, discriminator+" == "+value)
+			}
+			sw.Do("if $.$ {\n", strings.Join(conditions, " || "))
+		}
+		n.writeFeatureGatedDefaults(c, varName, index, isPointer, recording, sw)
+		n.writeDefaulter(c, varName, index, isPointer, recording, sw)
+		if n.discriminator != nil {
+			sw.Do("}\n", nil)
+		}
+		n.writeCalls(varName, isPointer, recording, sw)
+		n.writeListMemberDefaults(varName, index, recording, sw)
 		for i := range n.children {
-			n.children[i].WriteMethod(c, varName, depth, append(ancestors, n), sw)
+			n.children[i].WriteMethod(c, varName, depth, append(ancestors, n), recording, sw)
 		}
 	}
 
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"io"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+)
+
+// goldenUpdateEnvVar is the environment variable which makes the generated
+// golden tests write their golden data instead of comparing against it.
+const goldenUpdateEnvVar = "UPDATE_DEFAULTS_GOLDEN_DATA"
+
+// genDefaulterGoldenTests produces a test file which applies the generated
+// object defaulters to zero-valued objects and compares the results, as
+// JSON, against golden files in testdata/defaults.
+type genDefaulterGoldenTests struct {
+	generator.GoGenerator
+	outputPackage string
+	defaulters    *genDefaulter
+	imports       namer.ImportTracker
+}
+
+// NewGenDefaulterGoldenTests returns a generator which writes golden tests for
+// the object defaulters generated by defaulters. It must run after
+// defaulters in the same target.
+func NewGenDefaulterGoldenTests(outputFilename, outputPackage string, defaulters *genDefaulter) generator.Generator {
+	return &genDefaulterGoldenTests{
+		GoGenerator: generator.GoGenerator{
+			OutputFilename: outputFilename,
+		},
+		outputPackage: outputPackage,
+		defaulters:    defaulters,
+		imports:       generator.NewImportTrackerForPackage(outputPackage),
+	}
+}
+
+func (g *genDefaulterGoldenTests) Namers(c *generator.Context) namer.NameSystems {
+	return namer.NameSystems{
+		"raw": namer.NewRawNamer(g.outputPackage, g.imports),
+	}
+}
+
+func (g *genDefaulterGoldenTests) Filter(c *generator.Context, t *types.Type) bool {
+	return false
+}
+
+func (g *genDefaulterGoldenTests) Imports(c *generator.Context) (imports []string) {
+	var importLines []string
+	for _, singleImport := range g.imports.ImportLines() {
+		if g.defaulters.isOtherPackage(singleImport) {
+			importLines = append(importLines, singleImport)
+		}
+	}
+	return importLines
+}
+
+func (g *genDefaulterGoldenTests) Init(c *generator.Context, w io.Writer) error {
+	sw := generator.NewSnippetWriter(w, c, "$", "$")
+	if len(g.defaulters.typesForInit) == 0 {
+		return nil
+	}
+	args := generator.Args{
+		"T":             types.Ref("testing", "T"),
+		"MarshalIndent": types.Ref("encoding/json", "MarshalIndent"),
+		"Join":          types.Ref("path/filepath", "Join"),
+		"Getenv":        types.Ref("os", "Getenv"),
+		"MkdirAll":      types.Ref("os", "MkdirAll"),
+		"ReadFile":      types.Ref("os", "ReadFile"),
+		"WriteFile":     types.Ref("os", "WriteFile"),
+		"Equal":         types.Ref("bytes", "Equal"),
+		"envVar":        goldenUpdateEnvVar,
+	}
+	for _, t := range g.defaulters.typesForInit {
+		args := args.With("inType", t).With("name", t.Name.Name)
+		sw.Do("func TestGoldenDefaults_$.name$(t *$.T|raw$) {\n", args)
+		sw.Do("obj := &$.inType|raw${}\n", args)
+		sw.Do("$.inType|objectdefaultfn$(obj)\n", args)
+		sw.Do("checkGoldenDefaults(t, \"$.name$\", obj)\n", args)
+		sw.Do("}\n\n", nil)
+	}
+
+	sw.Do(`// checkGoldenDefaults compares the JSON serialization of the defaulted obj
+// against the golden file of the named type. Setting $.envVar$
+// writes the golden file instead.
+func checkGoldenDefaults(t *$.T|raw$, name string, obj interface{}) {
+	t.Helper()
+	got, err := $.MarshalIndent|raw$(obj, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+	path := $.Join|raw$("testdata", "defaults", name+".json")
+	if $.Getenv|raw$("$.envVar$") != "" {
+		if err := $.MkdirAll|raw$($.Join|raw$("testdata", "defaults"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := $.WriteFile|raw$(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := $.ReadFile|raw$(path)
+	if err != nil {
+		t.Fatalf("cannot read golden data, set $.envVar$=true to create it: %v", err)
+	}
+	if !$.Equal|raw$(want, got) {
+		t.Errorf("defaults of %s do not match %s, set $.envVar$=true to update it:\nwant:\n%s\ngot:\n%s", name, path, want, got)
+	}
+}
+`, args)
+	return sw.Error()
+}
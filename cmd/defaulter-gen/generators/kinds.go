@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"strings"
+
+	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+// groupVersionOf returns the group and version of the types of pkg, in the
+// same way as register-gen: the last two elements of the package path,
+// unless the group is overridden with a "+groupName" package comment.
+func groupVersionOf(pkg *types.Package) (string, string) {
+	pathParts := strings.Split(pkg.Path, "/")
+	group, version := "", pathParts[len(pathParts)-1]
+	if len(pathParts) > 1 {
+		group = pathParts[len(pathParts)-2]
+	}
+	if override := gengo.ExtractCommentTags("+", pkg.Comments)["groupName"]; override != nil {
+		group = override[0]
+	}
+	return group, version
+}
+
+// writeKindDefaulters writes a map from the kinds of the types package to
+// their object defaulters, and a function dispatching on it, for consumers
+// which apply defaults without a scheme.
+func (g *genDefaulter) writeKindDefaulters(c *generator.Context, sw *generator.SnippetWriter) {
+	group, version := groupVersionOf(c.Universe[g.typesPackage])
+	args := generator.Args{
+		"GroupVersionKind": types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupVersionKind"),
+		"group":            group,
+		"version":          version,
+	}
+
+	sw.Do("// KindDefaulters are the object defaulters of the kinds of this group\n", nil)
+	sw.Do("// version, for applying defaults without constructing a scheme. They\n", nil)
+	sw.Do("// return false if obj is not a pointer to the type of their kind.\n", nil)
+	sw.Do("var KindDefaulters = map[$.GroupVersionKind|raw$]func(obj interface{}) bool{\n", args)
+	for _, t := range g.typesForInit {
+		args := args.With("inType", t).With("kind", t.Name.Name)
+		sw.Do("{Group: \"$.group$\", Version: \"$.version$\", Kind: \"$.kind$\"}: func(obj interface{}) bool {\n", args)
+		sw.Do("in, ok := obj.(*$.inType|raw$)\n", args)
+		sw.Do("if !ok {\nreturn false\n}\n", nil)
+		sw.Do("$.inType|objectdefaultfn$(in)\n", args)
+		sw.Do("return true\n", nil)
+		sw.Do("},\n", nil)
+	}
+	sw.Do("}\n\n", nil)
+
+	sw.Do(`// SetObjectDefaultsForKind applies the object defaulter of the given kind to
+// obj, which must be a pointer to the type of the kind. It returns false if
+// the kind has no object defaulter in this group version, or obj is not of
+// its type.
+func SetObjectDefaultsForKind(gvk $.GroupVersionKind|raw$, obj interface{}) bool {
+	defaulter, ok := KindDefaulters[gvk]
+	if !ok {
+		return false
+	}
+	return defaulter(obj)
+}
+
+`, args)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	resourcePackagePath = "k8s.io/apimachinery/pkg/api/resource"
+	metav1PackagePath   = "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// typedLiteral is the default of a field of one of the types serialized as
+// strings for which defaults are parsed at generation time, and stored in
+// package-level variables of the generated file.
+type typedLiteral struct {
+	// t is the type of the value
+	t *types.Type
+	// init is a snippet initializing the variable, written with the args
+	// "type", "MustParse" and "Unix"
+	init string
+	// text identifies the value among those of the same type
+	text string
+	// deepCopy is true if the variable must be copied with DeepCopy
+	deepCopy bool
+	// varName is the name of the package-level variable, assigned when
+	// generating the defaulter
+	varName string
+}
+
+// parseTypedLiteral parses the default of a field of type t, if t is one of
+// resource.Quantity, metav1.Duration and metav1.Time. It returns nil for other
+// types.
+func parseTypedLiteral(t *types.Type, value interface{}) (*typedLiteral, error) {
+	var kind string
+	switch t.Name {
+	case types.Name{Package: resourcePackagePath, Name: "Quantity"}:
+		kind = "quantity"
+	case types.Name{Package: metav1PackagePath, Name: "Duration"}:
+		kind = "duration"
+	case types.Name{Package: metav1PackagePath, Name: "Time"}:
+		kind = "time"
+	default:
+		return nil, nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("the default of a %s must be a string, got %v", kind, value)
+	}
+
+	switch kind {
+	case "quantity":
+		q, err := resource.ParseQuantity(s)
+		if err != nil {
+			return nil, err
+		}
+		// Quantities cannot be built from their fields outside of their
+		// package, so they are parsed once on initialization.
+		canonical := q.String()
+		return &typedLiteral{t: t, init: "$.MustParse|raw$(" + strconv.Quote(canonical) + ")", text: canonical, deepCopy: true}, nil
+	case "duration":
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, err
+		}
+		return &typedLiteral{t: t, init: fmt.Sprintf("$.type|raw${Duration: %d}", int64(d)), text: d.String()}, nil
+	default:
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, err
+		}
+		return &typedLiteral{t: t, init: fmt.Sprintf("$.type|raw${Time: $.Unix|raw$(%d, %d).UTC()}", tm.Unix(), tm.Nanosecond()), text: tm.UTC().Format(time.RFC3339Nano)}, nil
+	}
+}
+
+// declareTypedLiteral assigns the package-level variable holding the typed
+// literal default of d, if any, sharing the variables of equal values.
+func (g *genDefaulter) declareTypedLiteral(d *defaultValue) {
+	lit := d.TypedLiteral
+	if lit == nil || lit.varName != "" {
+		return
+	}
+	n := 1
+	for _, declared := range g.typedLiterals {
+		if declared.t != lit.t {
+			continue
+		}
+		if declared.text == lit.text {
+			lit.varName = declared.varName
+			return
+		}
+		n++
+	}
+	lit.varName = fmt.Sprintf("default%s%d", lit.t.Name.Name, n)
+	g.typedLiterals = append(g.typedLiterals, lit)
+}
+
+// writeTypedLiterals writes the package-level variables of the typed literal
+// defaults.
+func (g *genDefaulter) writeTypedLiterals(c *generator.Context, w io.Writer) error {
+	if len(g.typedLiterals) == 0 {
+		return nil
+	}
+	sw := generator.NewSnippetWriter(w, c, "$", "$")
+	sw.Do("// Defaults of types serialized as strings, parsed at generation time.\n", nil)
+	sw.Do("var (\n", nil)
+	for _, lit := range g.typedLiterals {
+		args := generator.Args{
+			"type":      lit.t,
+			"MustParse": types.Ref(resourcePackagePath, "MustParse"),
+			"Unix":      types.Ref("time", "Unix"),
+		}
+		sw.Do(lit.varName+" = "+lit.init+"\n", args)
+	}
+	sw.Do(")\n", nil)
+	return sw.Error()
+}
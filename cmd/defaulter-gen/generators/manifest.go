@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+	"k8s.io/klog/v2"
+)
+
+const defaultsManifestFileType = "defaultsmanifest"
+
+// newDefaultsManifestFile returns the file type of defaults manifests, which
+// are written as generated.
+func newDefaultsManifestFile() *generator.DefaultFileType {
+	return &generator.DefaultFileType{
+		Format: func(b []byte) ([]byte, error) { return b, nil },
+		Assemble: func(w io.Writer, f *generator.File) {
+			w.Write(f.Body.Bytes())
+		},
+	}
+}
+
+// defaultsManifest describes the defaults declared by markers on the types of
+// a package, in terms of their serialized form.
+type defaultsManifest struct {
+	Package string                  `json:"package"`
+	Types   map[string]typeDefaults `json:"types"`
+}
+
+// typeDefaults holds the default declared on a type, which applies to fields
+// of the type through pointers, and the defaults of its fields by JSON name.
+type typeDefaults struct {
+	markerDefault
+	Fields map[string]markerDefault `json:"fields,omitempty"`
+}
+
+// markerDefault is the default declared by the markers of a type or field.
+type markerDefault struct {
+	// Default is the value of a "+default" marker. For references to
+	// constants, this is the value of the constant, if known.
+	Default json.RawMessage `json:"default,omitempty"`
+	// Ref is the constant referenced by a "+default=ref(...)" marker, or
+	// named by a "+default=..." marker.
+	Ref string `json:"ref,omitempty"`
+	// CEL is the expression of a "+default:cel" marker.
+	CEL string `json:"cel,omitempty"`
+	// Field is the reference of a "+default:field" marker.
+	Field string `json:"field,omitempty"`
+	// Function is the function referenced by a "+default:ref" marker, whose
+	// result is only known at runtime.
+	Function string `json:"function,omitempty"`
+	// ListMembers are the values of "+default:listMember" markers.
+	ListMembers []json.RawMessage `json:"listMembers,omitempty"`
+	// DiscriminatorValues are the values of the union discriminator under
+	// which the defaults apply, from "+default:discriminatorValue" markers.
+	DiscriminatorValues []string `json:"discriminatorValues,omitempty"`
+	// FeatureGated are the values of "+default:featureGate" markers, by
+	// feature.
+	FeatureGated map[string]json.RawMessage `json:"featureGated,omitempty"`
+}
+
+func (d markerDefault) isEmpty() bool {
+	return reflect.DeepEqual(d, markerDefault{})
+}
+
+// genDefaultsManifest produces a JSON manifest of the defaults declared on
+// the types of a package.
+type genDefaultsManifest struct {
+	generator.GoGenerator
+	typesPackage string
+	universe     types.Universe
+	manifest     defaultsManifest
+}
+
+func NewGenDefaultsManifest(outputFilename, typesPackage string) generator.Generator {
+	return &genDefaultsManifest{
+		GoGenerator: generator.GoGenerator{
+			OutputFilename: outputFilename,
+		},
+		typesPackage: typesPackage,
+		manifest: defaultsManifest{
+			Package: typesPackage,
+			Types:   map[string]typeDefaults{},
+		},
+	}
+}
+
+func (g *genDefaultsManifest) FileType() string { return defaultsManifestFileType }
+
+func (g *genDefaultsManifest) Namers(c *generator.Context) namer.NameSystems {
+	return nil
+}
+
+func (g *genDefaultsManifest) Filter(c *generator.Context, t *types.Type) bool {
+	return t.Name.Package == g.typesPackage
+}
+
+func (g *genDefaultsManifest) Imports(c *generator.Context) []string {
+	return nil
+}
+
+func (g *genDefaultsManifest) Init(c *generator.Context, w io.Writer) error {
+	g.universe = c.Universe
+	return nil
+}
+
+func (g *genDefaultsManifest) GenerateType(c *generator.Context, t *types.Type, w io.Writer) error {
+	entry := typeDefaults{markerDefault: g.markerDefault(t.CommentLines, t.Name.Package)}
+	if t.Kind == types.Struct {
+		for _, m := range t.Members {
+			name := strings.Split(reflect.StructTag(m.Tags).Get("json"), ",")[0]
+			if name == "-" || (name == "" && m.Embedded) {
+				// Embedded structs are described by their own entries.
+				continue
+			}
+			if name == "" {
+				name = m.Name
+			}
+			if d := g.markerDefault(m.CommentLines, t.Name.Package); !d.isEmpty() {
+				if entry.Fields == nil {
+					entry.Fields = map[string]markerDefault{}
+				}
+				entry.Fields[name] = d
+			}
+		}
+	}
+	if !entry.isEmpty() || len(entry.Fields) > 0 {
+		g.manifest.Types[t.Name.Name] = entry
+	}
+	return nil
+}
+
+func (g *genDefaultsManifest) Finalize(c *generator.Context, w io.Writer) error {
+	b, err := json.MarshalIndent(g.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// markerDefault collects the defaults declared by the given comment lines,
+// using the same markers as the generated defaulters.
+func (g *genDefaultsManifest) markerDefault(commentLines []string, commentPackage string) markerDefault {
+	var d markerDefault
+	if values := extractDefaultTag(commentLines); len(values) == 1 && values[0] != "" {
+		d.Default, d.Ref = g.defaultValue(values[0], commentPackage)
+	}
+	if values := extractCELDefaultTag(commentLines); len(values) == 1 {
+		d.CEL = values[0]
+	}
+	if values := extractFieldDefaultTag(commentLines); len(values) == 1 {
+		d.Field = values[0]
+	}
+	if values := extractFunctionDefaultTag(commentLines); len(values) == 1 {
+		function := types.ParseFullyQualifiedName(values[0])
+		if len(function.Package) == 0 {
+			function.Package = commentPackage
+		}
+		d.Function = function.String()
+	}
+	for _, value := range extractListMemberDefaultTag(commentLines) {
+		d.ListMembers = append(d.ListMembers, json.RawMessage(value))
+	}
+	d.DiscriminatorValues = extractDiscriminatorDefaultTag(commentLines)
+	for _, value := range extractFeatureGateDefaultTag(commentLines) {
+		if feature, defaultString, ok := strings.Cut(value, "="); ok {
+			if d.FeatureGated == nil {
+				d.FeatureGated = map[string]json.RawMessage{}
+			}
+			d.FeatureGated[feature], _ = g.defaultValue(defaultString, commentPackage)
+		}
+	}
+	return d
+}
+
+// defaultValue returns the JSON value of a default written as for "+default",
+// and the name of the constant it references, if any.
+func (g *genDefaultsManifest) defaultValue(value, commentPackage string) (json.RawMessage, string) {
+	if ref, ok := parseSymbolReference(value, commentPackage); ok {
+		return g.constantValue(ref), ref.String()
+	}
+	if !json.Valid([]byte(value)) {
+		if ref, ok := parseConstantName(value, commentPackage); ok {
+			return g.constantValue(ref), ref.String()
+		}
+		klog.Fatalf("Failed to unmarshal default: %s", value)
+	}
+	return json.RawMessage(value), ""
+}
+
+// constantValue returns the JSON representation of the value of a constant,
+// or nil if the constant is not known.
+func (g *genDefaultsManifest) constantValue(name types.Name) json.RawMessage {
+	pkg, ok := g.universe[name.Package]
+	if !ok {
+		return nil
+	}
+	c, ok := pkg.Constants[name.Name]
+	if !ok || c.ConstValue == nil {
+		return nil
+	}
+	value := *c.ConstValue
+	// Untyped constants have types such as "untyped string".
+	if t, _ := resolveTypeAndDepth(c.Underlying); t != nil && strings.HasSuffix(t.Name.Name, "string") {
+		value = strconv.Quote(value)
+	}
+	if !json.Valid([]byte(value)) {
+		return nil
+	}
+	return json.RawMessage(value)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+)
+
+func recordingDefaultFnNamer() *namer.NameStrategy {
+	return &namer.NameStrategy{
+		Prefix: "SetObjectDefaultsWithRecorder_",
+		Join: func(pre string, in []string, post string) string {
+			return pre + strings.Join(in, "_") + post
+		},
+	}
+}
+
+// defaultsRecording tracks the JSON path of the value being defaulted while
+// writing the recording variant of an object defaulter. A nil
+// *defaultsRecording writes nothing, so that the same code writes both
+// variants.
+type defaultsRecording struct {
+	// format is the path, as a fmt format string for the values of the
+	// index and key variables in args.
+	format string
+	args   []string
+	// recorders maps the object defaulters generated in this package to the
+	// types they default, whose recording variants are called instead.
+	recorders map[*types.Type]*types.Type
+}
+
+func (r *defaultsRecording) field(jsonName string) *defaultsRecording {
+	if r == nil || jsonName == "" {
+		return r
+	}
+	out := *r
+	if out.format != "" {
+		out.format += "."
+	}
+	out.format += strings.ReplaceAll(jsonName, "%", "%%")
+	return &out
+}
+
+func (r *defaultsRecording) index(variable string, isKey bool) *defaultsRecording {
+	if r == nil {
+		return nil
+	}
+	out := *r
+	if isKey {
+		out.format += "[%v]"
+	} else {
+		out.format += "[%d]"
+	}
+	out.args = append(append([]string(nil), r.args...), variable)
+	return &out
+}
+
+// path returns a snippet evaluating to the path of the value being
+// defaulted, to be written with the args returned by snippetArgs.
+func (r *defaultsRecording) path() string {
+	if len(r.args) == 0 {
+		return strconv.Quote(r.format)
+	}
+	return "$.Sprintf|raw$(" + strconv.Quote(r.format) + ", " + strings.Join(r.args, ", ") + ")"
+}
+
+func (r *defaultsRecording) snippetArgs() generator.Args {
+	return generator.Args{"Sprintf": types.Ref("fmt", "Sprintf")}
+}
+
+// writeRecord writes the call recording that the value was defaulted.
+func (r *defaultsRecording) writeRecord(sw *generator.SnippetWriter) {
+	if r == nil {
+		return
+	}
+	sw.Do("recorder.RecordDefault("+r.path()+")\n", r.snippetArgs())
+}
+
+// recorder returns a snippet evaluating to the recorder to pass to the
+// recording variant of a nested object defaulter, which records paths
+// relative to its object.
+func (r *defaultsRecording) recorder() string {
+	if r.format == "" {
+		return "recorder"
+	}
+	return "prefixedDefaultsRecorder{prefix: " + r.path() + ", recorder: recorder}"
+}
+
+// recorderOf returns the type defaulted by fn, if fn is an object defaulter
+// with a recording variant.
+func (r *defaultsRecording) recorderOf(fn *types.Type) *types.Type {
+	if r == nil {
+		return nil
+	}
+	return r.recorders[fn]
+}
+
+// writeDefaultsRecorder writes the declarations used by the recording
+// variants of the object defaulters.
+func writeDefaultsRecorder(sw *generator.SnippetWriter) {
+	sw.Do(`// DefaultsRecorder is notified of the defaults applied by the
+// SetObjectDefaultsWithRecorder_ functions.
+type DefaultsRecorder interface {
+	// RecordDefault is called with the JSON path of a field, relative to the
+	// defaulted object, after a default declared by a marker was applied to it.
+	// Defaults applied by SetDefaults_ functions are not recorded.
+	RecordDefault(path string)
+}
+
+// prefixedDefaultsRecorder records the defaults of a nested object under the
+// path of the object.
+type prefixedDefaultsRecorder struct {
+	prefix   string
+	recorder DefaultsRecorder
+}
+
+func (r prefixedDefaultsRecorder) RecordDefault(path string) {
+	r.recorder.RecordDefault(r.prefix + "." + path)
+}
+
+`, nil)
+}
+
+// jsonMemberName returns the JSON name of a struct member, or an empty string
+// for embedded structs which are inlined in JSON.
+func jsonMemberName(m types.Member) string {
+	name := strings.Split(reflect.StructTag(m.Tags).Get("json"), ",")[0]
+	if name == "" && m.Embedded {
+		return ""
+	}
+	if name == "" || name == "-" {
+		return m.Name
+	}
+	return name
+}
@@ -39,6 +39,113 @@ limitations under the License.
 //
 // to indicate that the defaulter does not or should not call any nested
 // defaulters.
+//
+// Defaulters of types from other packages, which are neither generated nor
+// found in the peer packages, can be declared at the package level:
+//
+//	// +k8s:defaulter-gen-external=k8s.io/kubernetes/pkg/apis/core/v1.SetDefaults_PodSpec
+//
+// and are called on every field of the type, as the object defaulter of the
+// type if named SetObjectDefaults_*, and as its base defaulter otherwise.
+//
+// Besides JSON literals, a `+default` may name an exported constant of the
+// same package, or of another input or peer package by its full path, which
+// must exist when generating:
+//
+//	// +default=DefaultProtocol
+//	// +default=k8s.io/api/core/v1.ProtocolTCP
+//
+// A `+default` declared on a type other than a struct applies to every field
+// of that type, or of a pointer to it, which does not declare its own default.
+//
+// Defaults of resource.Quantity, metav1.Duration and metav1.Time fields, or
+// pointers to them, are written as their serialized strings:
+//
+//	// +default="500Mi"
+//
+// They are parsed when generating the defaulters, which copy them from
+// package-level variables.
+//
+// A field of a primitive type (or pointer to one) may compute its default
+// from the struct declaring it with a CEL expression:
+//
+//	// +default:cel=self.port + 1
+//
+// The expression is type-checked and compiled to Go at generation time;
+// `self` refers to the declaring struct and fields are selected by their
+// JSON names. Fields are defaulted in declaration order, so an expression
+// observes the defaults of the fields declared before it. As in CEL, integer
+// overflow and division by zero are errors: the field is then left unset, as
+// it is when the result does not fit in it.
+//
+// A default may also copy another field of the declaring struct, named by
+// its JSON name or a dotted path into nested structs, optionally offset by an
+// integer constant:
+//
+//	// +default:field=port+1
+//
+// The field is left unset if the offset overflows, or makes an unsigned field
+// negative.
+//
+// A default only known at runtime, such as a generated identifier, may be
+// computed by a function without arguments, in the same package as the
+// declaring struct or in an input or peer package:
+//
+//	// +default:ref=k8s.io/my/pkg.DefaultName
+//
+// The function must return the type of the field or, for primitive fields and
+// pointers to them, a type with the same underlying type; this is checked at
+// generation time. It is called whenever the field is unset.
+//
+// Defaults of the fields of map values are applied to every value. The
+// members of an associative list can be given defaults for primitive fields,
+// selected by the values of their keys:
+//
+//	// +listType=map
+//	// +listMapKey=name
+//	// +default:listMember={"name": "http", "port": 80}
+//
+// The defaults of the members of a discriminated union can be restricted to
+// some values of the field of the struct tagged +unionDiscriminator:
+//
+//	// +default:discriminatorValue=TCP
+//	// +default={"port": 80}
+//
+// The discriminator is defaulted before the members, wherever it is declared.
+//
+// Defaults which only apply when a feature is enabled are declared as
+//
+//	// +default:featureGate=MyFeature="value"
+//
+// and take precedence over the other defaults of the field. They apply when
+// their feature is enabled in the feature gate set with the SetFeatureGate
+// function generated in the package, which accepts the feature gates of
+// k8s.io/component-base/featuregate, so the signatures of the defaulters are
+// unchanged:
+//
+//	SetFeatureGate(utilfeature.DefaultFeatureGate)
+//
+// With --defaults-manifest-file, a JSON manifest of the defaults declared by
+// these markers, keyed by type and JSON field name, is written next to the
+// generated defaulters for use by schema and documentation tooling.
+//
+// With --defaults-recorder, each object defaulter has a variant named
+// SetObjectDefaultsWithRecorder_<Type> which reports the JSON path of every
+// field it defaults with a marker to a DefaultsRecorder, for example to warn
+// about defaulted fields on admission. Defaults applied by SetDefaults_
+// functions, and by object defaulters generated for other packages, are not
+// reported.
+//
+// With --kind-defaulters, the object defaulters are also listed in
+// KindDefaulters, keyed by GroupVersionKind, and SetObjectDefaultsForKind
+// applies them to decoded objects without constructing a scheme, returning
+// false rather than panicking for unknown kinds and objects of another type.
+// The group and version are taken from the types package as by register-gen.
+//
+// With --golden-test-file, a test is generated next to the defaulters which
+// applies each object defaulter to a zero-valued object and compares the
+// result, as JSON, against testdata/defaults/<Type>.json. Running the tests
+// with UPDATE_DEFAULTS_GOLDEN_DATA=true writes the golden files.
 package main
 
 import (
@@ -18,7 +18,7 @@ limitations under the License.
 
 // Ignore this file to prevent zz_generated for this package
 
-//go:generate go run k8s.io/code-generator/cmd/defaulter-gen --output-file zz_generated.defaults.go --go-header-file=../../../examples/hack/boilerplate.go.txt k8s.io/code-generator/cmd/defaulter-gen/output_tests/...
+//go:generate go run k8s.io/code-generator/cmd/defaulter-gen --output-file zz_generated.defaults.go --defaults-manifest-file zz_generated.defaults.json --golden-test-file zz_generated.defaults_test.go --defaults-recorder --kind-defaulters --go-header-file=../../../examples/hack/boilerplate.go.txt k8s.io/code-generator/cmd/defaulter-gen/output_tests/...
 package outputtests
 
 import (
@@ -30,3 +30,19 @@ func SetDefaults_DefaultedWithFunction(obj *DefaultedWithFunction) {
 		obj.S1 = "default_function"
 	}
 }
+
+func DefaultName() string {
+	return "generated"
+}
+
+func DefaultTimeoutSeconds() int64 {
+	return 30
+}
+
+func DefaultItem() ValueItem {
+	return SomeValue
+}
+
+func DefaultLabels() map[string]string {
+	return map[string]string{"generated": "true"}
+}
@@ -15,6 +15,7 @@ limitations under the License.
 */
 
 // +k8s:defaulter-gen=TypeMeta
+// +k8s:defaulter-gen-external=k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external4.SetDefaults_PodSpec
 
 // This is a test package.
 package marker
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package external4 holds a type which is embedded by the marker types, but
+// whose defaulter is not generated with theirs.
+package external4
+
+type PodSpec struct {
+	Image    string `json:"image,omitempty"`
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+func SetDefaults_PodSpec(obj *PodSpec) {
+	if obj.Image == "" {
+		obj.Image = "busybox"
+	}
+	if obj.Replicas == nil {
+		replicas := int32(1)
+		obj.Replicas = &replicas
+	}
+}
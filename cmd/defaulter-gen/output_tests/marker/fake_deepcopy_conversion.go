@@ -105,3 +105,263 @@ func (in *DefaultedWithReference) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+func (in *DefaultedWithExpression) GetObjectKind() schema.ObjectKind { return schema.EmptyObjectKind }
+
+func (in *DefaultedWithExpression) DeepCopy() *DefaultedWithExpression {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedWithExpression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedWithExpression) DeepCopyInto(out *DefaultedWithExpression) {
+	*out = *in
+}
+
+func (in *DefaultedWithExpression) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedWithFieldReference) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedWithFieldReference) DeepCopy() *DefaultedWithFieldReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedWithFieldReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedWithFieldReference) DeepCopyInto(out *DefaultedWithFieldReference) {
+	*out = *in
+}
+
+func (in *DefaultedWithFieldReference) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedMapValuesAndListMembers) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedMapValuesAndListMembers) DeepCopy() *DefaultedMapValuesAndListMembers {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedMapValuesAndListMembers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedMapValuesAndListMembers) DeepCopyInto(out *DefaultedMapValuesAndListMembers) {
+	*out = *in
+}
+
+func (in *DefaultedMapValuesAndListMembers) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedWithFeatureGate) GetObjectKind() schema.ObjectKind { return schema.EmptyObjectKind }
+
+func (in *DefaultedWithFeatureGate) DeepCopy() *DefaultedWithFeatureGate {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedWithFeatureGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedWithFeatureGate) DeepCopyInto(out *DefaultedWithFeatureGate) {
+	*out = *in
+}
+
+func (in *DefaultedWithFeatureGate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedWithFeatureGateChild) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedWithFeatureGateChild) DeepCopy() *DefaultedWithFeatureGateChild {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedWithFeatureGateChild)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedWithFeatureGateChild) DeepCopyInto(out *DefaultedWithFeatureGateChild) {
+	*out = *in
+}
+
+func (in *DefaultedWithFeatureGateChild) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedWithFunctionReference) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedWithFunctionReference) DeepCopy() *DefaultedWithFunctionReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedWithFunctionReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedWithFunctionReference) DeepCopyInto(out *DefaultedWithFunctionReference) {
+	*out = *in
+}
+
+func (in *DefaultedWithFunctionReference) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedWithTypedLiterals) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedWithTypedLiterals) DeepCopy() *DefaultedWithTypedLiterals {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedWithTypedLiterals)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedWithTypedLiterals) DeepCopyInto(out *DefaultedWithTypedLiterals) {
+	*out = *in
+}
+
+func (in *DefaultedWithTypedLiterals) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedWithExternalDefaulter) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedWithExternalDefaulter) DeepCopy() *DefaultedWithExternalDefaulter {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedWithExternalDefaulter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedWithExternalDefaulter) DeepCopyInto(out *DefaultedWithExternalDefaulter) {
+	*out = *in
+}
+
+func (in *DefaultedWithExternalDefaulter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedWithConstantName) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedWithConstantName) DeepCopy() *DefaultedWithConstantName {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedWithConstantName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedWithConstantName) DeepCopyInto(out *DefaultedWithConstantName) {
+	*out = *in
+}
+
+func (in *DefaultedWithConstantName) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedUnion) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedUnion) DeepCopy() *DefaultedUnion {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedUnion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedUnion) DeepCopyInto(out *DefaultedUnion) {
+	*out = *in
+}
+
+func (in *DefaultedUnion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *DefaultedUnionDiscriminatorLast) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (in *DefaultedUnionDiscriminatorLast) DeepCopy() *DefaultedUnionDiscriminatorLast {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultedUnionDiscriminatorLast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DefaultedUnionDiscriminatorLast) DeepCopyInto(out *DefaultedUnionDiscriminatorLast) {
+	*out = *in
+}
+
+func (in *DefaultedUnionDiscriminatorLast) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -18,13 +18,18 @@ package marker
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external"
 	externalexternal "k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external/external"
 	"k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external2"
 	"k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external3"
+	"k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external4"
 )
 
 func getPointerFromString(s string) *string {
@@ -387,6 +392,8 @@ func Test_DefaultingReference(t *testing.T) {
 				AliasOverride:                   Item(&SomeDefault),
 				AliasConvertDefaultPointer:      &dv,
 				AliasPointerDefault:             &dv,
+				AliasNonPointerDefault:          dv,
+				AliasNonPointerOverride:         "custom",
 				PointerAliasDefault:             Item(getPointerFromString("apple")),
 				AliasNonPointer:                 SomeValue,
 				AliasPointer:                    &SomeValue,
@@ -410,3 +417,465 @@ func Test_DefaultingReference(t *testing.T) {
 		})
 	}
 }
+
+func Test_DefaultingExpression(t *testing.T) {
+	testcases := []struct {
+		name string
+		in   DefaultedWithExpression
+		out  DefaultedWithExpression
+	}{
+		{
+			name: "default",
+			in:   DefaultedWithExpression{},
+			out: DefaultedWithExpression{
+				Port:        8080,
+				HealthPort:  8081,
+				ServiceName: getPointerFromString("unnamed"),
+				Value:       "prefix-",
+				Sub:         SubStruct{I: 1},
+			},
+		},
+		{
+			name: "computed",
+			in: DefaultedWithExpression{
+				Port:     9000,
+				Name:     "web",
+				Replicas: 3,
+				Sub:      SubStruct{I: 3},
+			},
+			out: DefaultedWithExpression{
+				Port:           9000,
+				HealthPort:     9001,
+				Name:           "web",
+				ServiceName:    getPointerFromString("web-svc"),
+				Ratio:          1.5,
+				Replicas:       3,
+				PortPerReplica: 3000,
+				Value:          "prefix-web",
+				Sub:            SubStruct{I: 3},
+			},
+		},
+		{
+			name: "in range",
+			in: DefaultedWithExpression{
+				Port: 2,
+				Sub:  SubStruct{I: 1},
+			},
+			out: DefaultedWithExpression{
+				Port:        2,
+				HealthPort:  3,
+				ServiceName: getPointerFromString("unnamed"),
+				Ratio:       0.5,
+				ScaledPort:  2000000,
+				Value:       "prefix-",
+				Sub:         SubStruct{I: 1},
+			},
+		},
+		{
+			name: "set",
+			in: DefaultedWithExpression{
+				Port:        8000,
+				HealthPort:  1234,
+				Name:        "frontend",
+				ServiceName: getPointerFromString("other"),
+			},
+			out: DefaultedWithExpression{
+				Port:        8000,
+				HealthPort:  1234,
+				Name:        "frontend",
+				ServiceName: getPointerFromString("other"),
+				LongName:    true,
+				Value:       "prefix-frontend",
+				Sub:         SubStruct{I: 1},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetObjectDefaults_DefaultedWithExpression(&tc.in)
+			if diff := cmp.Diff(tc.out, tc.in); len(diff) > 0 {
+				t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+			}
+		})
+	}
+}
+
+func Test_DefaultingFieldReference(t *testing.T) {
+	healthPort := int32(8081)
+	otherHealthPort := int32(9001)
+	testcases := []struct {
+		name string
+		in   DefaultedWithFieldReference
+		out  DefaultedWithFieldReference
+	}{
+		{
+			name: "default",
+			in:   DefaultedWithFieldReference{},
+			out: DefaultedWithFieldReference{
+				Spec: ServiceSpec{
+					Port:       8080,
+					HealthPort: &healthPort,
+					AdminPort:  8000,
+				},
+			},
+		},
+		{
+			name: "referenced fields set",
+			in: DefaultedWithFieldReference{
+				Spec: ServiceSpec{
+					Port:     9000,
+					Name:     "web",
+					Replicas: 3,
+				},
+			},
+			out: DefaultedWithFieldReference{
+				Spec: ServiceSpec{
+					Port:           9000,
+					HealthPort:     &otherHealthPort,
+					AdminPort:      8920,
+					Name:           "web",
+					DisplayName:    "web",
+					Replicas:       3,
+					MaxUnavailable: 2,
+				},
+				Name: "web",
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetObjectDefaults_DefaultedWithFieldReference(&tc.in)
+			if diff := cmp.Diff(tc.out, tc.in); len(diff) > 0 {
+				t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+			}
+		})
+	}
+}
+
+func Test_DefaultingMapValuesAndListMembers(t *testing.T) {
+	in := DefaultedMapValuesAndListMembers{
+		StructMap: map[string]SubStruct{
+			"unset": {S: "a"},
+			"set":   {S: "b", I: 2},
+		},
+		PtrStructMap: map[string]*SubStruct{
+			"unset": {S: "a"},
+			"nil":   nil,
+		},
+		Ports: []ServicePort{
+			{Name: "http", Protocol: "TCP"},
+			{Name: "http", Protocol: "UDP"},
+			{Name: "dns", Protocol: "UDP", Port: 5353},
+			{Name: "other", Protocol: "TCP"},
+		},
+	}
+	SetObjectDefaults_DefaultedMapValuesAndListMembers(&in)
+	out := DefaultedMapValuesAndListMembers{
+		StructMap: map[string]SubStruct{
+			"unset": {S: "a", I: 1},
+			"set":   {S: "b", I: 2},
+		},
+		PtrStructMap: map[string]*SubStruct{
+			"unset": {S: "a", I: 1},
+			"nil":   nil,
+		},
+		Ports: []ServicePort{
+			{Name: "http", Protocol: "TCP", Port: 80},
+			{Name: "http", Protocol: "UDP"},
+			{Name: "dns", Protocol: "UDP", Port: 5353, Optional: true},
+			{Name: "other", Protocol: "TCP"},
+		},
+	}
+	if diff := cmp.Diff(out, in); len(diff) > 0 {
+		t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+	}
+}
+
+// fakeFeature and fakeFeatureGate mirror the feature gates of
+// k8s.io/component-base/featuregate, whose features are named by a string type.
+type fakeFeature string
+
+type fakeFeatureGate map[fakeFeature]bool
+
+func (f fakeFeatureGate) Enabled(feature fakeFeature) bool {
+	return f[feature]
+}
+
+func (f fakeFeatureGate) KnownFeatures() []string {
+	return nil
+}
+
+// setFeatureGate sets the feature gate of the package for the duration of
+// the test.
+func setFeatureGate(t *testing.T, features FeatureGate[fakeFeature]) {
+	SetFeatureGate(features)
+	t.Cleanup(func() { SetFeatureGate[fakeFeature](nil) })
+}
+
+func Test_DefaultingFeatureGate(t *testing.T) {
+	replicas := int32(3)
+	testcases := []struct {
+		name     string
+		features FeatureGate[fakeFeature]
+		in       DefaultedWithFeatureGate
+		out      DefaultedWithFeatureGate
+	}{
+		{
+			name: "no feature gate",
+			in:   DefaultedWithFeatureGate{Child: &DefaultedWithFeatureGateChild{}},
+			out: DefaultedWithFeatureGate{
+				Mode:  "old",
+				Child: &DefaultedWithFeatureGateChild{},
+			},
+		},
+		{
+			name:     "features disabled",
+			features: fakeFeatureGate{},
+			in:       DefaultedWithFeatureGate{Child: &DefaultedWithFeatureGateChild{}},
+			out: DefaultedWithFeatureGate{
+				Mode:  "old",
+				Child: &DefaultedWithFeatureGateChild{},
+			},
+		},
+		{
+			name:     "features enabled",
+			features: fakeFeatureGate{"NewMode": true, "Replicas": true},
+			in:       DefaultedWithFeatureGate{Child: &DefaultedWithFeatureGateChild{}},
+			out: DefaultedWithFeatureGate{
+				Mode:     "new",
+				Replicas: &replicas,
+				Child:    &DefaultedWithFeatureGateChild{Name: SomeDefault},
+			},
+		},
+		{
+			name:     "set",
+			features: fakeFeatureGate{"NewMode": true},
+			in:       DefaultedWithFeatureGate{Mode: "other"},
+			out:      DefaultedWithFeatureGate{Mode: "other"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			setFeatureGate(t, tc.features)
+			SetObjectDefaults_DefaultedWithFeatureGate(&tc.in)
+			if diff := cmp.Diff(tc.out, tc.in); len(diff) > 0 {
+				t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+			}
+		})
+	}
+}
+
+func Test_DefaultingFunctionReference(t *testing.T) {
+	in := DefaultedWithFunctionReference{}
+	SetObjectDefaults_DefaultedWithFunctionReference(&in)
+	timeout := int64(30)
+	item := "Value"
+	itemPointer := Item(&item)
+	out := DefaultedWithFunctionReference{
+		Name:           "generated",
+		TimeoutSeconds: &timeout,
+		Item:           &itemPointer,
+		Labels:         map[string]string{"generated": "true"},
+	}
+	if diff := cmp.Diff(out, in); len(diff) > 0 {
+		t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+	}
+
+	set := DefaultedWithFunctionReference{Name: "set", Labels: map[string]string{}}
+	SetObjectDefaults_DefaultedWithFunctionReference(&set)
+	if set.Name != "set" || len(set.Labels) != 0 {
+		t.Errorf("Error: set fields were defaulted: %#v", set)
+	}
+}
+
+type fakeDefaultsRecorder []string
+
+func (r *fakeDefaultsRecorder) RecordDefault(path string) {
+	*r = append(*r, path)
+}
+
+func Test_DefaultsRecorder(t *testing.T) {
+	in := DefaultedWithFeatureGate{Mode: "set", Child: &DefaultedWithFeatureGateChild{}}
+	recorder := fakeDefaultsRecorder{}
+	setFeatureGate(t, fakeFeatureGate{"Replicas": true})
+	SetObjectDefaultsWithRecorder_DefaultedWithFeatureGate(&in, &recorder)
+	expected := fakeDefaultsRecorder{"Replicas", "Child.Name"}
+	if diff := cmp.Diff(expected, recorder); len(diff) > 0 {
+		t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+	}
+
+	members := DefaultedMapValuesAndListMembers{
+		StructMap: map[string]SubStruct{"a": {}},
+		Ports:     []ServicePort{{Name: "other", Protocol: "TCP"}, {Name: "dns", Protocol: "UDP"}},
+	}
+	recorder = fakeDefaultsRecorder{}
+	SetObjectDefaultsWithRecorder_DefaultedMapValuesAndListMembers(&members, &recorder)
+	expected = fakeDefaultsRecorder{"StructMap[a].I", "Ports[1].port", "Ports[1].optional"}
+	if diff := cmp.Diff(expected, recorder); len(diff) > 0 {
+		t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+	}
+}
+
+func Test_DefaultingTypedLiterals(t *testing.T) {
+	in := DefaultedWithTypedLiterals{}
+	SetObjectDefaults_DefaultedWithTypedLiterals(&in)
+	memory := resource.MustParse("500Mi")
+	interval := metav1.Duration{Duration: 90 * time.Second}
+	out := DefaultedWithTypedLiterals{
+		Memory:      memory,
+		MemoryLimit: &memory,
+		Timeout:     metav1.Duration{Duration: 30 * time.Second},
+		Interval:    &interval,
+		NotBefore:   metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	if diff := cmp.Diff(out, in); len(diff) > 0 {
+		t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+	}
+
+	// Defaults are copies, which may be modified independently.
+	in.MemoryLimit.Add(resource.MustParse("1Gi"))
+	other := DefaultedWithTypedLiterals{}
+	SetObjectDefaults_DefaultedWithTypedLiterals(&other)
+	if !other.MemoryLimit.Equal(memory) {
+		t.Errorf("Error: default was modified: %v", other.MemoryLimit)
+	}
+}
+
+func Test_DefaultingExternalDefaulter(t *testing.T) {
+	in := DefaultedWithExternalDefaulter{Template: &external4.PodSpec{Image: "nginx"}}
+	SetObjectDefaults_DefaultedWithExternalDefaulter(&in)
+	replicas := int32(1)
+	out := DefaultedWithExternalDefaulter{
+		PodSpec:  external4.PodSpec{Image: "busybox", Replicas: &replicas},
+		Template: &external4.PodSpec{Image: "nginx", Replicas: &replicas},
+	}
+	if diff := cmp.Diff(out, in); len(diff) > 0 {
+		t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+	}
+}
+
+func Test_KindDefaulters(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "output_tests", Version: "marker", Kind: "DefaultedWithFunction"}
+	in := DefaultedWithFunction{}
+	if !SetObjectDefaultsForKind(gvk, &in) {
+		t.Fatalf("Error: no defaulter for %v", gvk)
+	}
+	out := DefaultedWithFunction{
+		S1: "default_function",
+		S2: "default_marker",
+	}
+	if diff := cmp.Diff(out, in); len(diff) > 0 {
+		t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+	}
+
+	gvk.Kind = "DefaultedWithFeatureGate"
+	gated := DefaultedWithFeatureGate{}
+	setFeatureGate(t, fakeFeatureGate{"NewMode": true})
+	if !SetObjectDefaultsForKind(gvk, &gated) {
+		t.Fatalf("Error: no defaulter for %v", gvk)
+	}
+	if gated.Mode != "new" {
+		t.Errorf("Error: Expected feature-gated default, got %q", gated.Mode)
+	}
+
+	if SetObjectDefaultsForKind(gvk, &in) {
+		t.Errorf("Error: Unexpected defaulting of %T as %v", &in, gvk)
+	}
+
+	gvk.Kind = "Unknown"
+	if SetObjectDefaultsForKind(gvk, &in) {
+		t.Errorf("Error: Unexpected defaulter for %v", gvk)
+	}
+}
+
+func Test_DefaultingConstantName(t *testing.T) {
+	in := DefaultedWithConstantName{}
+	SetObjectDefaults_DefaultedWithConstantName(&in)
+	value := SomeValue
+	out := DefaultedWithConstantName{
+		SymbolReference:         SomeDefault,
+		AliasPointer:            &value,
+		ExternalSymbolReference: external.AConstant,
+		Enabled:                 true,
+	}
+	if diff := cmp.Diff(out, in); len(diff) > 0 {
+		t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+	}
+}
+
+func Test_DefaultingUnion(t *testing.T) {
+	timeout := int32(30)
+	testcases := []struct {
+		name string
+		in   DefaultedUnion
+		out  DefaultedUnion
+	}{
+		{
+			name: "default discriminator",
+			in:   DefaultedUnion{},
+			out: DefaultedUnion{
+				Type:           "TCP",
+				TCP:            &UnionSettings{Port: 80},
+				TimeoutSeconds: timeout,
+			},
+		},
+		{
+			name: "other member",
+			in:   DefaultedUnion{Type: "UDP"},
+			out: DefaultedUnion{
+				Type:           "UDP",
+				UDP:            &UnionSettings{Port: 53},
+				TimeoutSeconds: timeout,
+			},
+		},
+		{
+			name: "no member",
+			in:   DefaultedUnion{Type: "None"},
+			out:  DefaultedUnion{Type: "None"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetObjectDefaults_DefaultedUnion(&tc.in)
+			if diff := cmp.Diff(tc.out, tc.in); len(diff) > 0 {
+				t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+			}
+		})
+	}
+}
+
+func Test_DefaultingUnionDiscriminatorLast(t *testing.T) {
+	testcases := []struct {
+		name string
+		in   DefaultedUnionDiscriminatorLast
+		out  DefaultedUnionDiscriminatorLast
+	}{
+		{
+			name: "default discriminator",
+			in:   DefaultedUnionDiscriminatorLast{},
+			out: DefaultedUnionDiscriminatorLast{
+				Type: "TCP",
+				TCP:  &UnionSettings{Port: 80},
+			},
+		},
+		{
+			name: "other member",
+			in:   DefaultedUnionDiscriminatorLast{Type: "UDP"},
+			out: DefaultedUnionDiscriminatorLast{
+				Type: "UDP",
+				UDP:  &UnionSettings{Port: 53},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetObjectDefaults_DefaultedUnionDiscriminatorLast(&tc.in)
+			if diff := cmp.Diff(tc.out, tc.in); len(diff) > 0 {
+				t.Errorf("Error: Expected and actual output are different \n %s\n", diff)
+			}
+		})
+	}
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package marker
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/code-generator/cmd/defaulter-gen/output_tests/empty"
 	"k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external3"
+	"k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external4"
 )
 
 type Defaulted struct {
@@ -215,10 +218,14 @@ type DefaultedWithReference struct {
 	// +default=ref(SomeDefault)
 	AliasOverride Item
 
-	// Type-level default is not respected unless a pointer
+	// Type-level default is inherited by fields of the type
 	AliasNonPointerDefault DefaultedValueItem `json:",omitempty"`
 
-	// Type-level default is not respected unless a pointer
+	// Type-level default can be overridden by the field
+	// +default="custom"
+	AliasNonPointerOverride DefaultedValueItem `json:",omitempty"`
+
+	// Type-level default is inherited through a pointer
 	AliasPointerDefault *DefaultedValueItem
 
 	// Can have value typed alias
@@ -256,6 +263,236 @@ type DefaultedWithReference struct {
 	ImportFromAliasCast external3.StringPointer
 }
 
+type DefaultedWithExpression struct {
+	empty.TypeMeta
+
+	// +default=8080
+	Port int32 `json:"port,omitempty"`
+
+	// Computed from a field defaulted before it
+	// +default:cel=self.port + 1
+	HealthPort int32 `json:"healthPort,omitempty"`
+
+	Name string `json:"name,omitempty"`
+
+	// +default:cel=self.name == "" ? "unnamed" : self.name + '-svc'
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// +default:cel=size(self.name) > 3 && self.port >= 8000
+	LongName bool `json:"longName,omitempty"`
+
+	// +default:cel=double(self.sub.I) / 2.0
+	Ratio float64 `json:"ratio,omitempty"`
+
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Left unset when there are no replicas
+	// +default:cel=self.port / self.replicas
+	PortPerReplica int32 `json:"portPerReplica,omitempty"`
+
+	// Left unset when out of the range of the field
+	// +default:cel=self.port * 1000000
+	ScaledPort int32 `json:"scaledPort,omitempty"`
+
+	// Assigned through the alias
+	// +default:cel="prefix-" + self.name
+	Value ValueItem `json:"value,omitempty"`
+
+	Sub SubStruct `json:"sub"`
+}
+
+type DefaultedWithFieldReference struct {
+	empty.TypeMeta
+
+	Spec ServiceSpec `json:"spec"`
+
+	// Copied from a field of a nested struct
+	// +default:field=spec.name
+	Name string `json:"name,omitempty"`
+}
+
+type ServiceSpec struct {
+	// +default=8080
+	Port int32 `json:"port,omitempty"`
+
+	// +default:field=port+1
+	HealthPort *int32 `json:"healthPort,omitempty"`
+
+	// +default:field=port - 80
+	AdminPort int64 `json:"adminPort,omitempty"`
+
+	Name string `json:"name,omitempty"`
+
+	// Converted to the alias
+	// +default:field=name
+	DisplayName ValueItem `json:"displayName,omitempty"`
+
+	Replicas uint32 `json:"replicas,omitempty"`
+
+	// Left unset rather than wrapped around when there are no replicas
+	// +default:field=replicas - 1
+	MaxUnavailable uint32 `json:"maxUnavailable,omitempty"`
+}
+
+type DefaultedMapValuesAndListMembers struct {
+	empty.TypeMeta
+
+	// The defaults of SubStruct are applied to each value
+	StructMap map[string]SubStruct
+
+	PtrStructMap map[string]*SubStruct
+
+	// +listType=map
+	// +listMapKey=name
+	// +listMapKey=protocol
+	// +default:listMember={"name": "http", "protocol": "TCP", "port": 80}
+	// +default:listMember={"name": "dns", "protocol": "UDP", "port": 53, "optional": true}
+	Ports []ServicePort
+}
+
+type ServicePort struct {
+	Name     string    `json:"name"`
+	Protocol ValueItem `json:"protocol"`
+	Port     int32     `json:"port,omitempty"`
+	Optional bool      `json:"optional,omitempty"`
+}
+
+type DefaultedWithFeatureGate struct {
+	empty.TypeMeta
+
+	// The feature-gated default takes precedence when the feature is enabled
+	// +default:featureGate=NewMode="new"
+	// +default="old"
+	Mode string
+
+	// +default:featureGate=Replicas=3
+	Replicas *int32
+
+	// The feature gate is passed to the defaulter of the child
+	Child *DefaultedWithFeatureGateChild
+}
+
+type DefaultedWithFeatureGateChild struct {
+	empty.TypeMeta
+
+	// +default:featureGate=Replicas=ref(SomeDefault)
+	Name string
+}
+
+type DefaultedWithFunctionReference struct {
+	empty.TypeMeta
+
+	// The function is called at runtime
+	// +default:ref=DefaultName
+	Name string
+
+	// +default:ref=DefaultTimeoutSeconds
+	TimeoutSeconds *int64
+
+	// The function returns a type with the same underlying type
+	// +default:ref=DefaultItem
+	Item *Item
+
+	// +default:ref=DefaultLabels
+	Labels map[string]string
+}
+
+type DefaultedWithTypedLiterals struct {
+	empty.TypeMeta
+
+	// Literals are parsed at generation time
+	// +default="500Mi"
+	Memory resource.Quantity
+
+	// +default="500Mi"
+	MemoryLimit *resource.Quantity
+
+	// +default="30s"
+	Timeout metav1.Duration
+
+	// +default="1m30s"
+	Interval *metav1.Duration
+
+	// +default="2024-01-01T00:00:00Z"
+	NotBefore metav1.Time
+}
+
+type DefaultedWithExternalDefaulter struct {
+	empty.TypeMeta
+
+	// The defaulter of the embedded type is declared in doc.go
+	external4.PodSpec `json:",inline"`
+
+	Template *external4.PodSpec `json:"template,omitempty"`
+}
+
+type DefaultedWithConstantName struct {
+	empty.TypeMeta
+
+	// Constants may be named without ref()
+	// +default=SomeDefault
+	SymbolReference string
+
+	// +default=SomeValue
+	AliasPointer *ValueItem
+
+	// +default=k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external.AConstant
+	ExternalSymbolReference string
+
+	// JSON literals are not constants
+	// +default=true
+	Enabled bool
+}
+
+type DefaultedUnion struct {
+	empty.TypeMeta
+
+	// +unionDiscriminator
+	// +default="TCP"
+	Type UnionType `json:"type,omitempty"`
+
+	// Only defaulted when the discriminator selects it
+	// +unionMember
+	// +default:discriminatorValue=TCP
+	// +default={"port": 80}
+	TCP *UnionSettings `json:"tcp,omitempty"`
+
+	// +unionMember
+	// +default:discriminatorValue=UDP
+	// +default={"port": 53}
+	UDP *UnionSettings `json:"udp,omitempty"`
+
+	// +default:discriminatorValue=TCP
+	// +default:discriminatorValue=UDP
+	// +default=30
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// The discriminator is defaulted before the members wherever it is declared
+type DefaultedUnionDiscriminatorLast struct {
+	empty.TypeMeta
+
+	// +unionMember
+	// +default:discriminatorValue=TCP
+	// +default={"port": 80}
+	TCP *UnionSettings `json:"tcp,omitempty"`
+
+	// +unionMember
+	// +default:discriminatorValue=UDP
+	// +default={"port": 53}
+	UDP *UnionSettings `json:"udp,omitempty"`
+
+	// +unionDiscriminator
+	// +default="TCP"
+	Type UnionType `json:"type,omitempty"`
+}
+
+type UnionType string
+
+type UnionSettings struct {
+	Port int32 `json:"port,omitempty"`
+}
+
 // Super complicated hierarchy of aliases which includes multiple pointers,
 // and sibling types.
 type B0 *string
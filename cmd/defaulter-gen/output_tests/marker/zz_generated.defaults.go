@@ -23,11 +23,19 @@ package marker
 
 import (
 	json "encoding/json"
+	fmt "fmt"
+	math "math"
+	bits "math/bits"
+	time "time"
 
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	external "k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external"
 	externalexternal "k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external/external"
 	external2 "k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external2"
+	external4 "k8s.io/code-generator/cmd/defaulter-gen/output_tests/marker/external4"
 )
 
 // RegisterDefaults adds defaulters functions to the given scheme.
@@ -35,12 +43,217 @@ import (
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&Defaulted{}, func(obj interface{}) { SetObjectDefaults_Defaulted(obj.(*Defaulted)) })
+	scheme.AddTypeDefaultingFunc(&DefaultedMapValuesAndListMembers{}, func(obj interface{}) {
+		SetObjectDefaults_DefaultedMapValuesAndListMembers(obj.(*DefaultedMapValuesAndListMembers))
+	})
 	scheme.AddTypeDefaultingFunc(&DefaultedOmitempty{}, func(obj interface{}) { SetObjectDefaults_DefaultedOmitempty(obj.(*DefaultedOmitempty)) })
+	scheme.AddTypeDefaultingFunc(&DefaultedUnion{}, func(obj interface{}) { SetObjectDefaults_DefaultedUnion(obj.(*DefaultedUnion)) })
+	scheme.AddTypeDefaultingFunc(&DefaultedUnionDiscriminatorLast{}, func(obj interface{}) {
+		SetObjectDefaults_DefaultedUnionDiscriminatorLast(obj.(*DefaultedUnionDiscriminatorLast))
+	})
+	scheme.AddTypeDefaultingFunc(&DefaultedWithConstantName{}, func(obj interface{}) { SetObjectDefaults_DefaultedWithConstantName(obj.(*DefaultedWithConstantName)) })
+	scheme.AddTypeDefaultingFunc(&DefaultedWithExpression{}, func(obj interface{}) { SetObjectDefaults_DefaultedWithExpression(obj.(*DefaultedWithExpression)) })
+	scheme.AddTypeDefaultingFunc(&DefaultedWithExternalDefaulter{}, func(obj interface{}) {
+		SetObjectDefaults_DefaultedWithExternalDefaulter(obj.(*DefaultedWithExternalDefaulter))
+	})
+	scheme.AddTypeDefaultingFunc(&DefaultedWithFeatureGate{}, func(obj interface{}) { SetObjectDefaults_DefaultedWithFeatureGate(obj.(*DefaultedWithFeatureGate)) })
+	scheme.AddTypeDefaultingFunc(&DefaultedWithFeatureGateChild{}, func(obj interface{}) {
+		SetObjectDefaults_DefaultedWithFeatureGateChild(obj.(*DefaultedWithFeatureGateChild))
+	})
+	scheme.AddTypeDefaultingFunc(&DefaultedWithFieldReference{}, func(obj interface{}) {
+		SetObjectDefaults_DefaultedWithFieldReference(obj.(*DefaultedWithFieldReference))
+	})
 	scheme.AddTypeDefaultingFunc(&DefaultedWithFunction{}, func(obj interface{}) { SetObjectDefaults_DefaultedWithFunction(obj.(*DefaultedWithFunction)) })
+	scheme.AddTypeDefaultingFunc(&DefaultedWithFunctionReference{}, func(obj interface{}) {
+		SetObjectDefaults_DefaultedWithFunctionReference(obj.(*DefaultedWithFunctionReference))
+	})
 	scheme.AddTypeDefaultingFunc(&DefaultedWithReference{}, func(obj interface{}) { SetObjectDefaults_DefaultedWithReference(obj.(*DefaultedWithReference)) })
+	scheme.AddTypeDefaultingFunc(&DefaultedWithTypedLiterals{}, func(obj interface{}) { SetObjectDefaults_DefaultedWithTypedLiterals(obj.(*DefaultedWithTypedLiterals)) })
 	return nil
 }
 
+// FeatureGate reports whether a feature is enabled. It is satisfied by
+// k8s.io/component-base/featuregate.FeatureGate.
+type FeatureGate[F ~string] interface {
+	Enabled(feature F) bool
+}
+
+// featureEnabled reports whether a feature is enabled in the feature gate set
+// by SetFeatureGate, if any.
+var featureEnabled func(feature string) bool
+
+// SetFeatureGate sets the feature gate consulted by the defaulters of this
+// package for the defaults which only apply when their feature is enabled.
+// Until it is set, or when set to nil, these defaults do not apply. It is not
+// safe to call concurrently with the defaulters.
+func SetFeatureGate[F ~string](features FeatureGate[F]) {
+	if features == nil {
+		featureEnabled = nil
+		return
+	}
+	featureEnabled = func(feature string) bool {
+		return features.Enabled(F(feature))
+	}
+}
+
+// KindDefaulters are the object defaulters of the kinds of this group
+// version, for applying defaults without constructing a scheme. They
+// return false if obj is not a pointer to the type of their kind.
+var KindDefaulters = map[schema.GroupVersionKind]func(obj interface{}) bool{
+	{Group: "output_tests", Version: "marker", Kind: "Defaulted"}: func(obj interface{}) bool {
+		in, ok := obj.(*Defaulted)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_Defaulted(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedMapValuesAndListMembers"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedMapValuesAndListMembers)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedMapValuesAndListMembers(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedOmitempty"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedOmitempty)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedOmitempty(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedUnion"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedUnion)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedUnion(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedUnionDiscriminatorLast"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedUnionDiscriminatorLast)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedUnionDiscriminatorLast(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithConstantName"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithConstantName)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithConstantName(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithExpression"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithExpression)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithExpression(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithExternalDefaulter"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithExternalDefaulter)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithExternalDefaulter(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithFeatureGate"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithFeatureGate)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithFeatureGate(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithFeatureGateChild"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithFeatureGateChild)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithFeatureGateChild(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithFieldReference"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithFieldReference)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithFieldReference(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithFunction"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithFunction)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithFunction(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithFunctionReference"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithFunctionReference)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithFunctionReference(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithReference"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithReference)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithReference(in)
+		return true
+	},
+	{Group: "output_tests", Version: "marker", Kind: "DefaultedWithTypedLiterals"}: func(obj interface{}) bool {
+		in, ok := obj.(*DefaultedWithTypedLiterals)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_DefaultedWithTypedLiterals(in)
+		return true
+	},
+}
+
+// SetObjectDefaultsForKind applies the object defaulter of the given kind to
+// obj, which must be a pointer to the type of the kind. It returns false if
+// the kind has no object defaulter in this group version, or obj is not of
+// its type.
+func SetObjectDefaultsForKind(gvk schema.GroupVersionKind, obj interface{}) bool {
+	defaulter, ok := KindDefaulters[gvk]
+	if !ok {
+		return false
+	}
+	return defaulter(obj)
+}
+
+// DefaultsRecorder is notified of the defaults applied by the
+// SetObjectDefaultsWithRecorder_ functions.
+type DefaultsRecorder interface {
+	// RecordDefault is called with the JSON path of a field, relative to the
+	// defaulted object, after a default declared by a marker was applied to it.
+	// Defaults applied by SetDefaults_ functions are not recorded.
+	RecordDefault(path string)
+}
+
+// prefixedDefaultsRecorder records the defaults of a nested object under the
+// path of the object.
+type prefixedDefaultsRecorder struct {
+	prefix   string
+	recorder DefaultsRecorder
+}
+
+func (r prefixedDefaultsRecorder) RecordDefault(path string) {
+	r.recorder.RecordDefault(r.prefix + "." + path)
+}
+
 func SetObjectDefaults_Defaulted(in *Defaulted) {
 	if in.StringDefault == "" {
 		in.StringDefault = "bar"
@@ -132,81 +345,112 @@ func SetObjectDefaults_Defaulted(in *Defaulted) {
 			panic(err)
 		}
 	}
+	for i_StructMap := range in.StructMap {
+		a := in.StructMap[i_StructMap]
+		if a.I == 0 {
+			a.I = 1
+		}
+		in.StructMap[i_StructMap] = a
+	}
 	if in.PtrStructMap == nil {
 		if err := json.Unmarshal([]byte(`{"foo": {"S": "string", "I": 1}}`), &in.PtrStructMap); err != nil {
 			panic(err)
 		}
 	}
+	for i_PtrStructMap := range in.PtrStructMap {
+		a := in.PtrStructMap[i_PtrStructMap]
+		if a != nil {
+			if a.I == 0 {
+				a.I = 1
+			}
+		}
+	}
 	if in.AliasPtr == nil {
 		var ptrVar1 string = "banana"
 		in.AliasPtr = &ptrVar1
 	}
 }
 
-func SetObjectDefaults_DefaultedOmitempty(in *DefaultedOmitempty) {
+// SetObjectDefaultsWithRecorder_Defaulted is like SetObjectDefaults_Defaulted,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_Defaulted(in *Defaulted, recorder DefaultsRecorder) {
 	if in.StringDefault == "" {
 		in.StringDefault = "bar"
+		recorder.RecordDefault("StringDefault")
 	}
 	if in.StringPointer == nil {
 		var ptrVar1 string = "default"
 		in.StringPointer = &ptrVar1
+		recorder.RecordDefault("StringPointer")
 	}
 	if in.Int64 == nil {
 		var ptrVar1 int64 = 64
 		in.Int64 = &ptrVar1
+		recorder.RecordDefault("Int64")
 	}
 	if in.Int32 == nil {
 		var ptrVar1 int32 = 32
 		in.Int32 = &ptrVar1
+		recorder.RecordDefault("Int32")
 	}
 	if in.IntDefault == 0 {
 		in.IntDefault = 1
+		recorder.RecordDefault("IntDefault")
 	}
 	if in.FloatDefault == 0 {
 		in.FloatDefault = 0.5
+		recorder.RecordDefault("FloatDefault")
 	}
 	if in.List == nil {
 		if err := json.Unmarshal([]byte(`["foo", "bar"]`), &in.List); err != nil {
 			panic(err)
 		}
+		recorder.RecordDefault("List")
 	}
 	for i := range in.List {
 		if in.List[i] == nil {
 			var ptrVar1 string = "apple"
 			in.List[i] = &ptrVar1
+			recorder.RecordDefault(fmt.Sprintf("List[%d]", i))
 		}
 	}
 	if in.Sub == nil {
 		if err := json.Unmarshal([]byte(`{"s": "foo", "i": 5}`), &in.Sub); err != nil {
 			panic(err)
 		}
+		recorder.RecordDefault("Sub")
 	}
 	if in.Sub != nil {
 		if in.Sub.I == 0 {
 			in.Sub.I = 1
+			recorder.RecordDefault("Sub.I")
 		}
 	}
 	if in.StructList == nil {
 		if err := json.Unmarshal([]byte(`[{"s": "foo1", "i": 1}, {"s": "foo2"}]`), &in.StructList); err != nil {
 			panic(err)
 		}
+		recorder.RecordDefault("StructList")
 	}
 	for i := range in.StructList {
 		a := &in.StructList[i]
 		if a.I == 0 {
 			a.I = 1
+			recorder.RecordDefault(fmt.Sprintf("StructList[%d].I", i))
 		}
 	}
 	if in.PtrStructList == nil {
 		if err := json.Unmarshal([]byte(`[{"s": "foo1", "i": 1}, {"s": "foo2"}]`), &in.PtrStructList); err != nil {
 			panic(err)
 		}
+		recorder.RecordDefault("PtrStructList")
 	}
 	for i := range in.PtrStructList {
 		a := in.PtrStructList[i]
 		if a != nil {
 			if a.I == 0 {
 				a.I = 1
+				recorder.RecordDefault(fmt.Sprintf("PtrStructList[%d].I", i))
 			}
 		}
 	}
@@ -214,112 +458,1223 @@ func SetObjectDefaults_DefaultedOmitempty(in *DefaultedOmitempty) {
 		if err := json.Unmarshal([]byte(`["foo"]`), &in.StringList); err != nil {
 			panic(err)
 		}
+		recorder.RecordDefault("StringList")
 	}
 	if in.OtherSub.I == 0 {
 		in.OtherSub.I = 1
+		recorder.RecordDefault("OtherSub.I")
 	}
 	if in.Map == nil {
 		if err := json.Unmarshal([]byte(`{"foo": "bar"}`), &in.Map); err != nil {
 			panic(err)
 		}
+		recorder.RecordDefault("Map")
 	}
 	for i_Map := range in.Map {
 		if in.Map[i_Map] == nil {
 			var ptrVar1 string = "apple"
 			in.Map[i_Map] = &ptrVar1
+			recorder.RecordDefault(fmt.Sprintf("Map[%v]", i_Map))
 		}
 	}
 	if in.StructMap == nil {
 		if err := json.Unmarshal([]byte(`{"foo": {"S": "string", "I": 1}}`), &in.StructMap); err != nil {
 			panic(err)
 		}
+		recorder.RecordDefault("StructMap")
+	}
+	for i_StructMap := range in.StructMap {
+		a := in.StructMap[i_StructMap]
+		if a.I == 0 {
+			a.I = 1
+			recorder.RecordDefault(fmt.Sprintf("StructMap[%v].I", i_StructMap))
+		}
+		in.StructMap[i_StructMap] = a
 	}
 	if in.PtrStructMap == nil {
 		if err := json.Unmarshal([]byte(`{"foo": {"S": "string", "I": 1}}`), &in.PtrStructMap); err != nil {
 			panic(err)
 		}
+		recorder.RecordDefault("PtrStructMap")
+	}
+	for i_PtrStructMap := range in.PtrStructMap {
+		a := in.PtrStructMap[i_PtrStructMap]
+		if a != nil {
+			if a.I == 0 {
+				a.I = 1
+				recorder.RecordDefault(fmt.Sprintf("PtrStructMap[%v].I", i_PtrStructMap))
+			}
+		}
 	}
 	if in.AliasPtr == nil {
 		var ptrVar1 string = "banana"
 		in.AliasPtr = &ptrVar1
+		recorder.RecordDefault("AliasPtr")
 	}
 }
 
-func SetObjectDefaults_DefaultedWithFunction(in *DefaultedWithFunction) {
-	SetDefaults_DefaultedWithFunction(in)
-	if in.S1 == "" {
-		in.S1 = "default_marker"
+func SetObjectDefaults_DefaultedMapValuesAndListMembers(in *DefaultedMapValuesAndListMembers) {
+	for i_StructMap := range in.StructMap {
+		a := in.StructMap[i_StructMap]
+		if a.I == 0 {
+			a.I = 1
+		}
+		in.StructMap[i_StructMap] = a
 	}
-	if in.S2 == "" {
-		in.S2 = "default_marker"
+	for i_PtrStructMap := range in.PtrStructMap {
+		a := in.PtrStructMap[i_PtrStructMap]
+		if a != nil {
+			if a.I == 0 {
+				a.I = 1
+			}
+		}
+	}
+	for i := range in.Ports {
+		if in.Ports[i].Name == "http" && in.Ports[i].Protocol == "TCP" {
+			if in.Ports[i].Port == 0 {
+				in.Ports[i].Port = 80
+			}
+		}
+		if in.Ports[i].Name == "dns" && in.Ports[i].Protocol == "UDP" {
+			if in.Ports[i].Port == 0 {
+				in.Ports[i].Port = 53
+			}
+			if in.Ports[i].Optional == false {
+				in.Ports[i].Optional = true
+			}
+		}
 	}
 }
 
-func SetObjectDefaults_DefaultedWithReference(in *DefaultedWithReference) {
-	if in.AliasConvertDefaultPointer == nil {
-		ptrVar1 := DefaultedValueItem(SomeValue)
-		in.AliasConvertDefaultPointer = &ptrVar1
+// SetObjectDefaultsWithRecorder_DefaultedMapValuesAndListMembers is like SetObjectDefaults_DefaultedMapValuesAndListMembers,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedMapValuesAndListMembers(in *DefaultedMapValuesAndListMembers, recorder DefaultsRecorder) {
+	for i_StructMap := range in.StructMap {
+		a := in.StructMap[i_StructMap]
+		if a.I == 0 {
+			a.I = 1
+			recorder.RecordDefault(fmt.Sprintf("StructMap[%v].I", i_StructMap))
+		}
+		in.StructMap[i_StructMap] = a
 	}
-	if in.PointerAliasDefault == nil {
-		var ptrVar1 string = "apple"
-		in.PointerAliasDefault = &ptrVar1
+	for i_PtrStructMap := range in.PtrStructMap {
+		a := in.PtrStructMap[i_PtrStructMap]
+		if a != nil {
+			if a.I == 0 {
+				a.I = 1
+				recorder.RecordDefault(fmt.Sprintf("PtrStructMap[%v].I", i_PtrStructMap))
+			}
+		}
 	}
-	if in.AliasPointerInside == nil {
-		ptrVar1 := string(SomeDefault)
-		in.AliasPointerInside = &ptrVar1
+	for i := range in.Ports {
+		if in.Ports[i].Name == "http" && in.Ports[i].Protocol == "TCP" {
+			if in.Ports[i].Port == 0 {
+				in.Ports[i].Port = 80
+				recorder.RecordDefault(fmt.Sprintf("Ports[%d].port", i))
+			}
+		}
+		if in.Ports[i].Name == "dns" && in.Ports[i].Protocol == "UDP" {
+			if in.Ports[i].Port == 0 {
+				in.Ports[i].Port = 53
+				recorder.RecordDefault(fmt.Sprintf("Ports[%d].port", i))
+			}
+			if in.Ports[i].Optional == false {
+				in.Ports[i].Optional = true
+				recorder.RecordDefault(fmt.Sprintf("Ports[%d].optional", i))
+			}
+		}
 	}
-	if in.AliasOverride == nil {
-		ptrVar1 := string(SomeDefault)
-		in.AliasOverride = &ptrVar1
+}
+
+func SetObjectDefaults_DefaultedOmitempty(in *DefaultedOmitempty) {
+	if in.StringDefault == "" {
+		in.StringDefault = "bar"
 	}
-	if in.AliasPointerDefault == nil {
-		ptrVar1 := DefaultedValueItem(SomeValue)
-		in.AliasPointerDefault = &ptrVar1
+	if in.StringPointer == nil {
+		var ptrVar1 string = "default"
+		in.StringPointer = &ptrVar1
 	}
-	if in.AliasNonPointer == "" {
-		in.AliasNonPointer = ValueItem(SomeValue)
+	if in.Int64 == nil {
+		var ptrVar1 int64 = 64
+		in.Int64 = &ptrVar1
 	}
-	if in.AliasPointer == nil {
-		ptrVar1 := ValueItem(SomeValue)
-		in.AliasPointer = &ptrVar1
+	if in.Int32 == nil {
+		var ptrVar1 int32 = 32
+		in.Int32 = &ptrVar1
 	}
-	if in.SymbolReference == "" {
-		in.SymbolReference = string(SomeDefault)
+	if in.IntDefault == 0 {
+		in.IntDefault = 1
 	}
-	if in.SameNamePackageSymbolReference1 == "" {
-		in.SameNamePackageSymbolReference1 = string(external.AConstant)
+	if in.FloatDefault == 0 {
+		in.FloatDefault = 0.5
 	}
-	if in.SameNamePackageSymbolReference2 == "" {
-		in.SameNamePackageSymbolReference2 = string(externalexternal.AnotherConstant)
+	if in.List == nil {
+		if err := json.Unmarshal([]byte(`["foo", "bar"]`), &in.List); err != nil {
+			panic(err)
+		}
 	}
-	if in.PointerConversion == nil {
-		ptrVar9 := string(SomeValue)
-		ptrVar8 := &ptrVar9
-		ptrVar7 := (*B1)(&ptrVar8)
-		ptrVar6 := (*B2)(&ptrVar7)
-		ptrVar5 := &ptrVar6
-		ptrVar4 := &ptrVar5
-		ptrVar3 := &ptrVar4
-		ptrVar2 := (*B3)(&ptrVar3)
-		ptrVar1 := &ptrVar2
-		in.PointerConversion = (*B4)(&ptrVar1)
+	for i := range in.List {
+		if in.List[i] == nil {
+			var ptrVar1 string = "apple"
+			in.List[i] = &ptrVar1
+		}
 	}
-	if in.PointerConversionValue == nil {
-		ptrVar8 := string(SomeValue)
-		ptrVar7 := &ptrVar8
-		ptrVar6 := (*B1)(&ptrVar7)
-		ptrVar5 := (*B2)(&ptrVar6)
-		ptrVar4 := &ptrVar5
-		ptrVar3 := &ptrVar4
-		ptrVar2 := &ptrVar3
-		ptrVar1 := (*B3)(&ptrVar2)
-		in.PointerConversionValue = &ptrVar1
+	if in.Sub == nil {
+		if err := json.Unmarshal([]byte(`{"s": "foo", "i": 5}`), &in.Sub); err != nil {
+			panic(err)
+		}
 	}
-	if in.FullyQualifiedLocalSymbol == "" {
-		in.FullyQualifiedLocalSymbol = string(SomeValue)
+	if in.Sub != nil {
+		if in.Sub.I == 0 {
+			in.Sub.I = 1
+		}
 	}
-	if in.ImportFromAliasCast == nil {
-		ptrVar1 := external2.String(SomeValue)
-		in.ImportFromAliasCast = &ptrVar1
+	if in.StructList == nil {
+		if err := json.Unmarshal([]byte(`[{"s": "foo1", "i": 1}, {"s": "foo2"}]`), &in.StructList); err != nil {
+			panic(err)
+		}
+	}
+	for i := range in.StructList {
+		a := &in.StructList[i]
+		if a.I == 0 {
+			a.I = 1
+		}
+	}
+	if in.PtrStructList == nil {
+		if err := json.Unmarshal([]byte(`[{"s": "foo1", "i": 1}, {"s": "foo2"}]`), &in.PtrStructList); err != nil {
+			panic(err)
+		}
+	}
+	for i := range in.PtrStructList {
+		a := in.PtrStructList[i]
+		if a != nil {
+			if a.I == 0 {
+				a.I = 1
+			}
+		}
+	}
+	if in.StringList == nil {
+		if err := json.Unmarshal([]byte(`["foo"]`), &in.StringList); err != nil {
+			panic(err)
+		}
+	}
+	if in.OtherSub.I == 0 {
+		in.OtherSub.I = 1
+	}
+	if in.Map == nil {
+		if err := json.Unmarshal([]byte(`{"foo": "bar"}`), &in.Map); err != nil {
+			panic(err)
+		}
+	}
+	for i_Map := range in.Map {
+		if in.Map[i_Map] == nil {
+			var ptrVar1 string = "apple"
+			in.Map[i_Map] = &ptrVar1
+		}
+	}
+	if in.StructMap == nil {
+		if err := json.Unmarshal([]byte(`{"foo": {"S": "string", "I": 1}}`), &in.StructMap); err != nil {
+			panic(err)
+		}
+	}
+	for i_StructMap := range in.StructMap {
+		a := in.StructMap[i_StructMap]
+		if a.I == 0 {
+			a.I = 1
+		}
+		in.StructMap[i_StructMap] = a
+	}
+	if in.PtrStructMap == nil {
+		if err := json.Unmarshal([]byte(`{"foo": {"S": "string", "I": 1}}`), &in.PtrStructMap); err != nil {
+			panic(err)
+		}
+	}
+	for i_PtrStructMap := range in.PtrStructMap {
+		a := in.PtrStructMap[i_PtrStructMap]
+		if a != nil {
+			if a.I == 0 {
+				a.I = 1
+			}
+		}
+	}
+	if in.AliasPtr == nil {
+		var ptrVar1 string = "banana"
+		in.AliasPtr = &ptrVar1
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedOmitempty is like SetObjectDefaults_DefaultedOmitempty,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedOmitempty(in *DefaultedOmitempty, recorder DefaultsRecorder) {
+	if in.StringDefault == "" {
+		in.StringDefault = "bar"
+		recorder.RecordDefault("StringDefault")
+	}
+	if in.StringPointer == nil {
+		var ptrVar1 string = "default"
+		in.StringPointer = &ptrVar1
+		recorder.RecordDefault("StringPointer")
+	}
+	if in.Int64 == nil {
+		var ptrVar1 int64 = 64
+		in.Int64 = &ptrVar1
+		recorder.RecordDefault("Int64")
+	}
+	if in.Int32 == nil {
+		var ptrVar1 int32 = 32
+		in.Int32 = &ptrVar1
+		recorder.RecordDefault("Int32")
+	}
+	if in.IntDefault == 0 {
+		in.IntDefault = 1
+		recorder.RecordDefault("IntDefault")
+	}
+	if in.FloatDefault == 0 {
+		in.FloatDefault = 0.5
+		recorder.RecordDefault("FloatDefault")
+	}
+	if in.List == nil {
+		if err := json.Unmarshal([]byte(`["foo", "bar"]`), &in.List); err != nil {
+			panic(err)
+		}
+		recorder.RecordDefault("List")
+	}
+	for i := range in.List {
+		if in.List[i] == nil {
+			var ptrVar1 string = "apple"
+			in.List[i] = &ptrVar1
+			recorder.RecordDefault(fmt.Sprintf("List[%d]", i))
+		}
+	}
+	if in.Sub == nil {
+		if err := json.Unmarshal([]byte(`{"s": "foo", "i": 5}`), &in.Sub); err != nil {
+			panic(err)
+		}
+		recorder.RecordDefault("Sub")
+	}
+	if in.Sub != nil {
+		if in.Sub.I == 0 {
+			in.Sub.I = 1
+			recorder.RecordDefault("Sub.I")
+		}
+	}
+	if in.StructList == nil {
+		if err := json.Unmarshal([]byte(`[{"s": "foo1", "i": 1}, {"s": "foo2"}]`), &in.StructList); err != nil {
+			panic(err)
+		}
+		recorder.RecordDefault("StructList")
+	}
+	for i := range in.StructList {
+		a := &in.StructList[i]
+		if a.I == 0 {
+			a.I = 1
+			recorder.RecordDefault(fmt.Sprintf("StructList[%d].I", i))
+		}
+	}
+	if in.PtrStructList == nil {
+		if err := json.Unmarshal([]byte(`[{"s": "foo1", "i": 1}, {"s": "foo2"}]`), &in.PtrStructList); err != nil {
+			panic(err)
+		}
+		recorder.RecordDefault("PtrStructList")
+	}
+	for i := range in.PtrStructList {
+		a := in.PtrStructList[i]
+		if a != nil {
+			if a.I == 0 {
+				a.I = 1
+				recorder.RecordDefault(fmt.Sprintf("PtrStructList[%d].I", i))
+			}
+		}
+	}
+	if in.StringList == nil {
+		if err := json.Unmarshal([]byte(`["foo"]`), &in.StringList); err != nil {
+			panic(err)
+		}
+		recorder.RecordDefault("StringList")
+	}
+	if in.OtherSub.I == 0 {
+		in.OtherSub.I = 1
+		recorder.RecordDefault("OtherSub.I")
+	}
+	if in.Map == nil {
+		if err := json.Unmarshal([]byte(`{"foo": "bar"}`), &in.Map); err != nil {
+			panic(err)
+		}
+		recorder.RecordDefault("Map")
+	}
+	for i_Map := range in.Map {
+		if in.Map[i_Map] == nil {
+			var ptrVar1 string = "apple"
+			in.Map[i_Map] = &ptrVar1
+			recorder.RecordDefault(fmt.Sprintf("Map[%v]", i_Map))
+		}
+	}
+	if in.StructMap == nil {
+		if err := json.Unmarshal([]byte(`{"foo": {"S": "string", "I": 1}}`), &in.StructMap); err != nil {
+			panic(err)
+		}
+		recorder.RecordDefault("StructMap")
+	}
+	for i_StructMap := range in.StructMap {
+		a := in.StructMap[i_StructMap]
+		if a.I == 0 {
+			a.I = 1
+			recorder.RecordDefault(fmt.Sprintf("StructMap[%v].I", i_StructMap))
+		}
+		in.StructMap[i_StructMap] = a
+	}
+	if in.PtrStructMap == nil {
+		if err := json.Unmarshal([]byte(`{"foo": {"S": "string", "I": 1}}`), &in.PtrStructMap); err != nil {
+			panic(err)
+		}
+		recorder.RecordDefault("PtrStructMap")
+	}
+	for i_PtrStructMap := range in.PtrStructMap {
+		a := in.PtrStructMap[i_PtrStructMap]
+		if a != nil {
+			if a.I == 0 {
+				a.I = 1
+				recorder.RecordDefault(fmt.Sprintf("PtrStructMap[%v].I", i_PtrStructMap))
+			}
+		}
+	}
+	if in.AliasPtr == nil {
+		var ptrVar1 string = "banana"
+		in.AliasPtr = &ptrVar1
+		recorder.RecordDefault("AliasPtr")
+	}
+}
+
+func SetObjectDefaults_DefaultedUnion(in *DefaultedUnion) {
+	if in.Type == "" {
+		in.Type = "TCP"
+	}
+	if in.Type == "TCP" {
+		if in.TCP == nil {
+			if err := json.Unmarshal([]byte(`{"port": 80}`), &in.TCP); err != nil {
+				panic(err)
+			}
+		}
+	}
+	if in.Type == "UDP" {
+		if in.UDP == nil {
+			if err := json.Unmarshal([]byte(`{"port": 53}`), &in.UDP); err != nil {
+				panic(err)
+			}
+		}
+	}
+	if in.Type == "TCP" || in.Type == "UDP" {
+		if in.TimeoutSeconds == 0 {
+			in.TimeoutSeconds = 30
+		}
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedUnion is like SetObjectDefaults_DefaultedUnion,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedUnion(in *DefaultedUnion, recorder DefaultsRecorder) {
+	if in.Type == "" {
+		in.Type = "TCP"
+		recorder.RecordDefault("type")
+	}
+	if in.Type == "TCP" {
+		if in.TCP == nil {
+			if err := json.Unmarshal([]byte(`{"port": 80}`), &in.TCP); err != nil {
+				panic(err)
+			}
+			recorder.RecordDefault("tcp")
+		}
+	}
+	if in.Type == "UDP" {
+		if in.UDP == nil {
+			if err := json.Unmarshal([]byte(`{"port": 53}`), &in.UDP); err != nil {
+				panic(err)
+			}
+			recorder.RecordDefault("udp")
+		}
+	}
+	if in.Type == "TCP" || in.Type == "UDP" {
+		if in.TimeoutSeconds == 0 {
+			in.TimeoutSeconds = 30
+			recorder.RecordDefault("timeoutSeconds")
+		}
+	}
+}
+
+func SetObjectDefaults_DefaultedUnionDiscriminatorLast(in *DefaultedUnionDiscriminatorLast) {
+	if in.Type == "" {
+		in.Type = "TCP"
+	}
+	if in.Type == "TCP" {
+		if in.TCP == nil {
+			if err := json.Unmarshal([]byte(`{"port": 80}`), &in.TCP); err != nil {
+				panic(err)
+			}
+		}
+	}
+	if in.Type == "UDP" {
+		if in.UDP == nil {
+			if err := json.Unmarshal([]byte(`{"port": 53}`), &in.UDP); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedUnionDiscriminatorLast is like SetObjectDefaults_DefaultedUnionDiscriminatorLast,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedUnionDiscriminatorLast(in *DefaultedUnionDiscriminatorLast, recorder DefaultsRecorder) {
+	if in.Type == "" {
+		in.Type = "TCP"
+		recorder.RecordDefault("type")
+	}
+	if in.Type == "TCP" {
+		if in.TCP == nil {
+			if err := json.Unmarshal([]byte(`{"port": 80}`), &in.TCP); err != nil {
+				panic(err)
+			}
+			recorder.RecordDefault("tcp")
+		}
+	}
+	if in.Type == "UDP" {
+		if in.UDP == nil {
+			if err := json.Unmarshal([]byte(`{"port": 53}`), &in.UDP); err != nil {
+				panic(err)
+			}
+			recorder.RecordDefault("udp")
+		}
+	}
+}
+
+func SetObjectDefaults_DefaultedWithConstantName(in *DefaultedWithConstantName) {
+	if in.SymbolReference == "" {
+		in.SymbolReference = string(SomeDefault)
+	}
+	if in.AliasPointer == nil {
+		ptrVar1 := ValueItem(SomeValue)
+		in.AliasPointer = &ptrVar1
+	}
+	if in.ExternalSymbolReference == "" {
+		in.ExternalSymbolReference = string(external.AConstant)
+	}
+	if in.Enabled == false {
+		in.Enabled = true
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithConstantName is like SetObjectDefaults_DefaultedWithConstantName,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithConstantName(in *DefaultedWithConstantName, recorder DefaultsRecorder) {
+	if in.SymbolReference == "" {
+		in.SymbolReference = string(SomeDefault)
+		recorder.RecordDefault("SymbolReference")
+	}
+	if in.AliasPointer == nil {
+		ptrVar1 := ValueItem(SomeValue)
+		in.AliasPointer = &ptrVar1
+		recorder.RecordDefault("AliasPointer")
+	}
+	if in.ExternalSymbolReference == "" {
+		in.ExternalSymbolReference = string(external.AConstant)
+		recorder.RecordDefault("ExternalSymbolReference")
+	}
+	if in.Enabled == false {
+		in.Enabled = true
+		recorder.RecordDefault("Enabled")
+	}
+}
+
+func SetObjectDefaults_DefaultedWithExpression(in *DefaultedWithExpression) {
+	if in.Port == 0 {
+		in.Port = 8080
+	}
+	if in.HealthPort == 0 {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultAddInt64(int64(in.Port), 1, &ok)
+			return value, ok && math.MinInt32 <= value && value <= math.MaxInt32
+		}(); ok {
+			in.HealthPort = int32(value)
+		}
+	}
+	if in.ServiceName == nil {
+		ptrVar1 := string(func() string {
+			if in.Name == "" {
+				return "unnamed"
+			}
+			return in.Name + "-svc"
+		}())
+		in.ServiceName = &ptrVar1
+	}
+	if in.LongName == false {
+		in.LongName = bool(int64(len(in.Name)) > 3 && int64(in.Port) >= 8000)
+	}
+	if in.Ratio == 0 {
+		in.Ratio = float64(float64(int64(in.Sub.I)) / 2.0)
+	}
+	if in.PortPerReplica == 0 {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultDivInt64(int64(in.Port), int64(in.Replicas), &ok)
+			return value, ok && math.MinInt32 <= value && value <= math.MaxInt32
+		}(); ok {
+			in.PortPerReplica = int32(value)
+		}
+	}
+	if in.ScaledPort == 0 {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultMulInt64(int64(in.Port), 1000000, &ok)
+			return value, ok && math.MinInt32 <= value && value <= math.MaxInt32
+		}(); ok {
+			in.ScaledPort = int32(value)
+		}
+	}
+	if in.Value == "" {
+		in.Value = ValueItem("prefix-" + in.Name)
+	}
+	if in.Sub.I == 0 {
+		in.Sub.I = 1
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithExpression is like SetObjectDefaults_DefaultedWithExpression,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithExpression(in *DefaultedWithExpression, recorder DefaultsRecorder) {
+	if in.Port == 0 {
+		in.Port = 8080
+		recorder.RecordDefault("port")
+	}
+	if in.HealthPort == 0 {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultAddInt64(int64(in.Port), 1, &ok)
+			return value, ok && math.MinInt32 <= value && value <= math.MaxInt32
+		}(); ok {
+			in.HealthPort = int32(value)
+			recorder.RecordDefault("healthPort")
+		}
+	}
+	if in.ServiceName == nil {
+		ptrVar1 := string(func() string {
+			if in.Name == "" {
+				return "unnamed"
+			}
+			return in.Name + "-svc"
+		}())
+		in.ServiceName = &ptrVar1
+		recorder.RecordDefault("serviceName")
+	}
+	if in.LongName == false {
+		in.LongName = bool(int64(len(in.Name)) > 3 && int64(in.Port) >= 8000)
+		recorder.RecordDefault("longName")
+	}
+	if in.Ratio == 0 {
+		in.Ratio = float64(float64(int64(in.Sub.I)) / 2.0)
+		recorder.RecordDefault("ratio")
+	}
+	if in.PortPerReplica == 0 {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultDivInt64(int64(in.Port), int64(in.Replicas), &ok)
+			return value, ok && math.MinInt32 <= value && value <= math.MaxInt32
+		}(); ok {
+			in.PortPerReplica = int32(value)
+			recorder.RecordDefault("portPerReplica")
+		}
+	}
+	if in.ScaledPort == 0 {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultMulInt64(int64(in.Port), 1000000, &ok)
+			return value, ok && math.MinInt32 <= value && value <= math.MaxInt32
+		}(); ok {
+			in.ScaledPort = int32(value)
+			recorder.RecordDefault("scaledPort")
+		}
+	}
+	if in.Value == "" {
+		in.Value = ValueItem("prefix-" + in.Name)
+		recorder.RecordDefault("value")
+	}
+	if in.Sub.I == 0 {
+		in.Sub.I = 1
+		recorder.RecordDefault("sub.I")
+	}
+}
+
+func SetObjectDefaults_DefaultedWithExternalDefaulter(in *DefaultedWithExternalDefaulter) {
+	external4.SetDefaults_PodSpec(&in.PodSpec)
+	if in.Template != nil {
+		external4.SetDefaults_PodSpec(in.Template)
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithExternalDefaulter is like SetObjectDefaults_DefaultedWithExternalDefaulter,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithExternalDefaulter(in *DefaultedWithExternalDefaulter, recorder DefaultsRecorder) {
+	external4.SetDefaults_PodSpec(&in.PodSpec)
+	if in.Template != nil {
+		external4.SetDefaults_PodSpec(in.Template)
+	}
+}
+
+func SetObjectDefaults_DefaultedWithFeatureGate(in *DefaultedWithFeatureGate) {
+	if featureEnabled != nil && featureEnabled("NewMode") {
+		if in.Mode == "" {
+			in.Mode = "new"
+		}
+	}
+	if in.Mode == "" {
+		in.Mode = "old"
+	}
+	if featureEnabled != nil && featureEnabled("Replicas") {
+		if in.Replicas == nil {
+			var ptrVar1 int32 = 3
+			in.Replicas = &ptrVar1
+		}
+	}
+	if in.Child != nil {
+		SetObjectDefaults_DefaultedWithFeatureGateChild(in.Child)
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithFeatureGate is like SetObjectDefaults_DefaultedWithFeatureGate,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithFeatureGate(in *DefaultedWithFeatureGate, recorder DefaultsRecorder) {
+	if featureEnabled != nil && featureEnabled("NewMode") {
+		if in.Mode == "" {
+			in.Mode = "new"
+			recorder.RecordDefault("Mode")
+		}
+	}
+	if in.Mode == "" {
+		in.Mode = "old"
+		recorder.RecordDefault("Mode")
+	}
+	if featureEnabled != nil && featureEnabled("Replicas") {
+		if in.Replicas == nil {
+			var ptrVar1 int32 = 3
+			in.Replicas = &ptrVar1
+			recorder.RecordDefault("Replicas")
+		}
+	}
+	if in.Child != nil {
+		SetObjectDefaultsWithRecorder_DefaultedWithFeatureGateChild(in.Child, prefixedDefaultsRecorder{prefix: "Child", recorder: recorder})
+	}
+}
+
+func SetObjectDefaults_DefaultedWithFeatureGateChild(in *DefaultedWithFeatureGateChild) {
+	if featureEnabled != nil && featureEnabled("Replicas") {
+		if in.Name == "" {
+			in.Name = string(SomeDefault)
+		}
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithFeatureGateChild is like SetObjectDefaults_DefaultedWithFeatureGateChild,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithFeatureGateChild(in *DefaultedWithFeatureGateChild, recorder DefaultsRecorder) {
+	if featureEnabled != nil && featureEnabled("Replicas") {
+		if in.Name == "" {
+			in.Name = string(SomeDefault)
+			recorder.RecordDefault("Name")
+		}
+	}
+}
+
+func SetObjectDefaults_DefaultedWithFieldReference(in *DefaultedWithFieldReference) {
+	if in.Spec.Port == 0 {
+		in.Spec.Port = 8080
+	}
+	if in.Spec.HealthPort == nil {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultAddInt64(int64(in.Spec.Port), 1, &ok)
+			return value, ok && math.MinInt32 <= value && value <= math.MaxInt32
+		}(); ok {
+			ptrVar1 := int32(value)
+			in.Spec.HealthPort = &ptrVar1
+		}
+	}
+	if in.Spec.AdminPort == 0 {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultSubInt64(int64(in.Spec.Port), 80, &ok)
+			return value, ok
+		}(); ok {
+			in.Spec.AdminPort = int64(value)
+		}
+	}
+	if in.Spec.DisplayName == "" {
+		in.Spec.DisplayName = ValueItem(in.Spec.Name)
+	}
+	if in.Spec.MaxUnavailable == 0 {
+		if value, ok := func() (value uint64, ok bool) {
+			ok = true
+			value = celDefaultSubUint64(uint64(in.Spec.Replicas), 1, &ok)
+			return value, ok && value <= math.MaxUint32
+		}(); ok {
+			in.Spec.MaxUnavailable = uint32(value)
+		}
+	}
+	if in.Name == "" {
+		in.Name = string(in.Spec.Name)
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithFieldReference is like SetObjectDefaults_DefaultedWithFieldReference,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithFieldReference(in *DefaultedWithFieldReference, recorder DefaultsRecorder) {
+	if in.Spec.Port == 0 {
+		in.Spec.Port = 8080
+		recorder.RecordDefault("spec.port")
+	}
+	if in.Spec.HealthPort == nil {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultAddInt64(int64(in.Spec.Port), 1, &ok)
+			return value, ok && math.MinInt32 <= value && value <= math.MaxInt32
+		}(); ok {
+			ptrVar1 := int32(value)
+			in.Spec.HealthPort = &ptrVar1
+			recorder.RecordDefault("spec.healthPort")
+		}
+	}
+	if in.Spec.AdminPort == 0 {
+		if value, ok := func() (value int64, ok bool) {
+			ok = true
+			value = celDefaultSubInt64(int64(in.Spec.Port), 80, &ok)
+			return value, ok
+		}(); ok {
+			in.Spec.AdminPort = int64(value)
+			recorder.RecordDefault("spec.adminPort")
+		}
+	}
+	if in.Spec.DisplayName == "" {
+		in.Spec.DisplayName = ValueItem(in.Spec.Name)
+		recorder.RecordDefault("spec.displayName")
+	}
+	if in.Spec.MaxUnavailable == 0 {
+		if value, ok := func() (value uint64, ok bool) {
+			ok = true
+			value = celDefaultSubUint64(uint64(in.Spec.Replicas), 1, &ok)
+			return value, ok && value <= math.MaxUint32
+		}(); ok {
+			in.Spec.MaxUnavailable = uint32(value)
+			recorder.RecordDefault("spec.maxUnavailable")
+		}
+	}
+	if in.Name == "" {
+		in.Name = string(in.Spec.Name)
+		recorder.RecordDefault("name")
+	}
+}
+
+func SetObjectDefaults_DefaultedWithFunction(in *DefaultedWithFunction) {
+	SetDefaults_DefaultedWithFunction(in)
+	if in.S1 == "" {
+		in.S1 = "default_marker"
+	}
+	if in.S2 == "" {
+		in.S2 = "default_marker"
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithFunction is like SetObjectDefaults_DefaultedWithFunction,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithFunction(in *DefaultedWithFunction, recorder DefaultsRecorder) {
+	SetDefaults_DefaultedWithFunction(in)
+	if in.S1 == "" {
+		in.S1 = "default_marker"
+		recorder.RecordDefault("S1")
+	}
+	if in.S2 == "" {
+		in.S2 = "default_marker"
+		recorder.RecordDefault("S2")
+	}
+}
+
+func SetObjectDefaults_DefaultedWithFunctionReference(in *DefaultedWithFunctionReference) {
+	if in.Name == "" {
+		in.Name = string(DefaultName())
+	}
+	if in.TimeoutSeconds == nil {
+		ptrVar1 := int64(DefaultTimeoutSeconds())
+		in.TimeoutSeconds = &ptrVar1
+	}
+	if in.Item == nil {
+		ptrVar2 := string(DefaultItem())
+		ptrVar1 := &ptrVar2
+		in.Item = (*Item)(&ptrVar1)
+	}
+	if in.Labels == nil {
+		in.Labels = DefaultLabels()
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithFunctionReference is like SetObjectDefaults_DefaultedWithFunctionReference,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithFunctionReference(in *DefaultedWithFunctionReference, recorder DefaultsRecorder) {
+	if in.Name == "" {
+		in.Name = string(DefaultName())
+		recorder.RecordDefault("Name")
+	}
+	if in.TimeoutSeconds == nil {
+		ptrVar1 := int64(DefaultTimeoutSeconds())
+		in.TimeoutSeconds = &ptrVar1
+		recorder.RecordDefault("TimeoutSeconds")
+	}
+	if in.Item == nil {
+		ptrVar2 := string(DefaultItem())
+		ptrVar1 := &ptrVar2
+		in.Item = (*Item)(&ptrVar1)
+		recorder.RecordDefault("Item")
+	}
+	if in.Labels == nil {
+		in.Labels = DefaultLabels()
+		recorder.RecordDefault("Labels")
+	}
+}
+
+func SetObjectDefaults_DefaultedWithReference(in *DefaultedWithReference) {
+	if in.AliasConvertDefaultPointer == nil {
+		ptrVar1 := DefaultedValueItem(SomeValue)
+		in.AliasConvertDefaultPointer = &ptrVar1
+	}
+	if in.PointerAliasDefault == nil {
+		var ptrVar1 string = "apple"
+		in.PointerAliasDefault = &ptrVar1
+	}
+	if in.AliasPointerInside == nil {
+		ptrVar1 := string(SomeDefault)
+		in.AliasPointerInside = &ptrVar1
+	}
+	if in.AliasOverride == nil {
+		ptrVar1 := string(SomeDefault)
+		in.AliasOverride = &ptrVar1
+	}
+	if in.AliasNonPointerDefault == "" {
+		in.AliasNonPointerDefault = DefaultedValueItem(SomeValue)
+	}
+	if in.AliasNonPointerOverride == "" {
+		in.AliasNonPointerOverride = "custom"
+	}
+	if in.AliasPointerDefault == nil {
+		ptrVar1 := DefaultedValueItem(SomeValue)
+		in.AliasPointerDefault = &ptrVar1
+	}
+	if in.AliasNonPointer == "" {
+		in.AliasNonPointer = ValueItem(SomeValue)
+	}
+	if in.AliasPointer == nil {
+		ptrVar1 := ValueItem(SomeValue)
+		in.AliasPointer = &ptrVar1
+	}
+	if in.SymbolReference == "" {
+		in.SymbolReference = string(SomeDefault)
+	}
+	if in.SameNamePackageSymbolReference1 == "" {
+		in.SameNamePackageSymbolReference1 = string(external.AConstant)
+	}
+	if in.SameNamePackageSymbolReference2 == "" {
+		in.SameNamePackageSymbolReference2 = string(externalexternal.AnotherConstant)
+	}
+	if in.PointerConversion == nil {
+		ptrVar9 := string(SomeValue)
+		ptrVar8 := &ptrVar9
+		ptrVar7 := (*B1)(&ptrVar8)
+		ptrVar6 := (*B2)(&ptrVar7)
+		ptrVar5 := &ptrVar6
+		ptrVar4 := &ptrVar5
+		ptrVar3 := &ptrVar4
+		ptrVar2 := (*B3)(&ptrVar3)
+		ptrVar1 := &ptrVar2
+		in.PointerConversion = (*B4)(&ptrVar1)
+	}
+	if in.PointerConversionValue == nil {
+		ptrVar8 := string(SomeValue)
+		ptrVar7 := &ptrVar8
+		ptrVar6 := (*B1)(&ptrVar7)
+		ptrVar5 := (*B2)(&ptrVar6)
+		ptrVar4 := &ptrVar5
+		ptrVar3 := &ptrVar4
+		ptrVar2 := &ptrVar3
+		ptrVar1 := (*B3)(&ptrVar2)
+		in.PointerConversionValue = &ptrVar1
+	}
+	if in.FullyQualifiedLocalSymbol == "" {
+		in.FullyQualifiedLocalSymbol = string(SomeValue)
+	}
+	if in.ImportFromAliasCast == nil {
+		ptrVar1 := external2.String(SomeValue)
+		in.ImportFromAliasCast = &ptrVar1
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithReference is like SetObjectDefaults_DefaultedWithReference,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithReference(in *DefaultedWithReference, recorder DefaultsRecorder) {
+	if in.AliasConvertDefaultPointer == nil {
+		ptrVar1 := DefaultedValueItem(SomeValue)
+		in.AliasConvertDefaultPointer = &ptrVar1
+		recorder.RecordDefault("AliasConvertDefaultPointer")
+	}
+	if in.PointerAliasDefault == nil {
+		var ptrVar1 string = "apple"
+		in.PointerAliasDefault = &ptrVar1
+		recorder.RecordDefault("PointerAliasDefault")
+	}
+	if in.AliasPointerInside == nil {
+		ptrVar1 := string(SomeDefault)
+		in.AliasPointerInside = &ptrVar1
+		recorder.RecordDefault("AliasPointerInside")
+	}
+	if in.AliasOverride == nil {
+		ptrVar1 := string(SomeDefault)
+		in.AliasOverride = &ptrVar1
+		recorder.RecordDefault("AliasOverride")
+	}
+	if in.AliasNonPointerDefault == "" {
+		in.AliasNonPointerDefault = DefaultedValueItem(SomeValue)
+		recorder.RecordDefault("AliasNonPointerDefault")
+	}
+	if in.AliasNonPointerOverride == "" {
+		in.AliasNonPointerOverride = "custom"
+		recorder.RecordDefault("AliasNonPointerOverride")
+	}
+	if in.AliasPointerDefault == nil {
+		ptrVar1 := DefaultedValueItem(SomeValue)
+		in.AliasPointerDefault = &ptrVar1
+		recorder.RecordDefault("AliasPointerDefault")
+	}
+	if in.AliasNonPointer == "" {
+		in.AliasNonPointer = ValueItem(SomeValue)
+		recorder.RecordDefault("AliasNonPointer")
+	}
+	if in.AliasPointer == nil {
+		ptrVar1 := ValueItem(SomeValue)
+		in.AliasPointer = &ptrVar1
+		recorder.RecordDefault("AliasPointer")
+	}
+	if in.SymbolReference == "" {
+		in.SymbolReference = string(SomeDefault)
+		recorder.RecordDefault("SymbolReference")
+	}
+	if in.SameNamePackageSymbolReference1 == "" {
+		in.SameNamePackageSymbolReference1 = string(external.AConstant)
+		recorder.RecordDefault("SameNamePackageSymbolReference1")
+	}
+	if in.SameNamePackageSymbolReference2 == "" {
+		in.SameNamePackageSymbolReference2 = string(externalexternal.AnotherConstant)
+		recorder.RecordDefault("SameNamePackageSymbolReference2")
+	}
+	if in.PointerConversion == nil {
+		ptrVar9 := string(SomeValue)
+		ptrVar8 := &ptrVar9
+		ptrVar7 := (*B1)(&ptrVar8)
+		ptrVar6 := (*B2)(&ptrVar7)
+		ptrVar5 := &ptrVar6
+		ptrVar4 := &ptrVar5
+		ptrVar3 := &ptrVar4
+		ptrVar2 := (*B3)(&ptrVar3)
+		ptrVar1 := &ptrVar2
+		in.PointerConversion = (*B4)(&ptrVar1)
+		recorder.RecordDefault("PointerConversion")
+	}
+	if in.PointerConversionValue == nil {
+		ptrVar8 := string(SomeValue)
+		ptrVar7 := &ptrVar8
+		ptrVar6 := (*B1)(&ptrVar7)
+		ptrVar5 := (*B2)(&ptrVar6)
+		ptrVar4 := &ptrVar5
+		ptrVar3 := &ptrVar4
+		ptrVar2 := &ptrVar3
+		ptrVar1 := (*B3)(&ptrVar2)
+		in.PointerConversionValue = &ptrVar1
+		recorder.RecordDefault("PointerConversionValue")
+	}
+	if in.FullyQualifiedLocalSymbol == "" {
+		in.FullyQualifiedLocalSymbol = string(SomeValue)
+		recorder.RecordDefault("FullyQualifiedLocalSymbol")
+	}
+	if in.ImportFromAliasCast == nil {
+		ptrVar1 := external2.String(SomeValue)
+		in.ImportFromAliasCast = &ptrVar1
+		recorder.RecordDefault("ImportFromAliasCast")
+	}
+}
+
+func SetObjectDefaults_DefaultedWithTypedLiterals(in *DefaultedWithTypedLiterals) {
+	if in.Memory == (resource.Quantity{}) {
+		in.Memory = defaultQuantity1.DeepCopy()
+	}
+	if in.MemoryLimit == nil {
+		ptrVar1 := defaultQuantity1.DeepCopy()
+		in.MemoryLimit = &ptrVar1
+	}
+	if in.Timeout == (v1.Duration{}) {
+		in.Timeout = defaultDuration1
+	}
+	if in.Interval == nil {
+		ptrVar1 := defaultDuration2
+		in.Interval = &ptrVar1
+	}
+	if in.NotBefore == (v1.Time{}) {
+		in.NotBefore = defaultTime1
+	}
+}
+
+// SetObjectDefaultsWithRecorder_DefaultedWithTypedLiterals is like SetObjectDefaults_DefaultedWithTypedLiterals,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_DefaultedWithTypedLiterals(in *DefaultedWithTypedLiterals, recorder DefaultsRecorder) {
+	if in.Memory == (resource.Quantity{}) {
+		in.Memory = defaultQuantity1.DeepCopy()
+		recorder.RecordDefault("Memory")
+	}
+	if in.MemoryLimit == nil {
+		ptrVar1 := defaultQuantity1.DeepCopy()
+		in.MemoryLimit = &ptrVar1
+		recorder.RecordDefault("MemoryLimit")
+	}
+	if in.Timeout == (v1.Duration{}) {
+		in.Timeout = defaultDuration1
+		recorder.RecordDefault("Timeout")
+	}
+	if in.Interval == nil {
+		ptrVar1 := defaultDuration2
+		in.Interval = &ptrVar1
+		recorder.RecordDefault("Interval")
+	}
+	if in.NotBefore == (v1.Time{}) {
+		in.NotBefore = defaultTime1
+		recorder.RecordDefault("NotBefore")
+	}
+}
+
+// Defaults of types serialized as strings, parsed at generation time.
+var (
+	defaultQuantity1 = resource.MustParse("500Mi")
+	defaultDuration1 = v1.Duration{Duration: 30000000000}
+	defaultDuration2 = v1.Duration{Duration: 90000000000}
+	defaultTime1     = v1.Time{Time: time.Unix(1704067200, 0).UTC()}
+)
+
+// The checked arithmetic of +default:cel expressions, which are not applied
+// if they overflow or divide by zero.
+func celDefaultAddInt64(l, r int64, ok *bool) int64 {
+	s := l + r
+	if (r > 0 && s < l) || (r < 0 && s > l) {
+		*ok = false
+	}
+	return s
+}
+
+func celDefaultSubInt64(l, r int64, ok *bool) int64 {
+	d := l - r
+	if (r > 0 && d > l) || (r < 0 && d < l) {
+		*ok = false
+	}
+	return d
+}
+
+func celDefaultMulInt64(l, r int64, ok *bool) int64 {
+	p := l * r
+	if (l == -1 && r == math.MinInt64) || (r == -1 && l == math.MinInt64) || (r != 0 && p/r != l) {
+		*ok = false
+	}
+	return p
+}
+
+func celDefaultDivInt64(l, r int64, ok *bool) int64 {
+	if r == 0 || (l == math.MinInt64 && r == -1) {
+		*ok = false
+		return 0
+	}
+	return l / r
+}
+
+func celDefaultRemInt64(l, r int64, ok *bool) int64 {
+	if r == 0 || (l == math.MinInt64 && r == -1) {
+		*ok = false
+		return 0
+	}
+	return l % r
+}
+
+func celDefaultNegInt64(v int64, ok *bool) int64 {
+	if v == math.MinInt64 {
+		*ok = false
+	}
+	return -v
+}
+
+func celDefaultAddUint64(l, r uint64, ok *bool) uint64 {
+	s, carry := bits.Add64(l, r, 0)
+	if carry != 0 {
+		*ok = false
+	}
+	return s
+}
+
+func celDefaultSubUint64(l, r uint64, ok *bool) uint64 {
+	d, borrow := bits.Sub64(l, r, 0)
+	if borrow != 0 {
+		*ok = false
+	}
+	return d
+}
+
+func celDefaultMulUint64(l, r uint64, ok *bool) uint64 {
+	hi, lo := bits.Mul64(l, r)
+	if hi != 0 {
+		*ok = false
+	}
+	return lo
+}
+
+func celDefaultDivUint64(l, r uint64, ok *bool) uint64 {
+	if r == 0 {
+		*ok = false
+		return 0
+	}
+	return l / r
+}
+
+func celDefaultRemUint64(l, r uint64, ok *bool) uint64 {
+	if r == 0 {
+		*ok = false
+		return 0
+	}
+	return l % r
+}
+
+func celDefaultInt64ToUint64(v int64, ok *bool) uint64 {
+	if v < 0 {
+		*ok = false
+	}
+	return uint64(v)
+}
+
+func celDefaultUint64ToInt64(v uint64, ok *bool) int64 {
+	if v > math.MaxInt64 {
+		*ok = false
+	}
+	return int64(v)
+}
+
+func celDefaultFloat64ToInt64(v float64, ok *bool) int64 {
+	if !(v >= -0x1p63 && v < 0x1p63) {
+		*ok = false
+		return 0
+	}
+	return int64(v)
+}
+
+func celDefaultFloat64ToUint64(v float64, ok *bool) uint64 {
+	if !(v > -1 && v < 0x1p64) {
+		*ok = false
+		return 0
 	}
+	return uint64(v)
 }
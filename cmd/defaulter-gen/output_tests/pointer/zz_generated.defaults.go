@@ -23,6 +23,7 @@ package pointer
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // RegisterDefaults adds defaulters functions to the given scheme.
@@ -34,13 +35,82 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	return nil
 }
 
+// KindDefaulters are the object defaulters of the kinds of this group
+// version, for applying defaults without constructing a scheme. They
+// return false if obj is not a pointer to the type of their kind.
+var KindDefaulters = map[schema.GroupVersionKind]func(obj interface{}) bool{
+	{Group: "output_tests", Version: "pointer", Kind: "Tpointer"}: func(obj interface{}) bool {
+		in, ok := obj.(*Tpointer)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_Tpointer(in)
+		return true
+	},
+	{Group: "output_tests", Version: "pointer", Kind: "Ttest"}: func(obj interface{}) bool {
+		in, ok := obj.(*Ttest)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_Ttest(in)
+		return true
+	},
+}
+
+// SetObjectDefaultsForKind applies the object defaulter of the given kind to
+// obj, which must be a pointer to the type of the kind. It returns false if
+// the kind has no object defaulter in this group version, or obj is not of
+// its type.
+func SetObjectDefaultsForKind(gvk schema.GroupVersionKind, obj interface{}) bool {
+	defaulter, ok := KindDefaulters[gvk]
+	if !ok {
+		return false
+	}
+	return defaulter(obj)
+}
+
+// DefaultsRecorder is notified of the defaults applied by the
+// SetObjectDefaultsWithRecorder_ functions.
+type DefaultsRecorder interface {
+	// RecordDefault is called with the JSON path of a field, relative to the
+	// defaulted object, after a default declared by a marker was applied to it.
+	// Defaults applied by SetDefaults_ functions are not recorded.
+	RecordDefault(path string)
+}
+
+// prefixedDefaultsRecorder records the defaults of a nested object under the
+// path of the object.
+type prefixedDefaultsRecorder struct {
+	prefix   string
+	recorder DefaultsRecorder
+}
+
+func (r prefixedDefaultsRecorder) RecordDefault(path string) {
+	r.recorder.RecordDefault(r.prefix + "." + path)
+}
+
 func SetObjectDefaults_Tpointer(in *Tpointer) {
 	SetDefaults_Tpointer(in)
 }
 
+// SetObjectDefaultsWithRecorder_Tpointer is like SetObjectDefaults_Tpointer,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_Tpointer(in *Tpointer, recorder DefaultsRecorder) {
+	SetDefaults_Tpointer(in)
+}
+
 func SetObjectDefaults_Ttest(in *Ttest) {
 	SetObjectDefaults_Tpointer(&in.NTP)
 	if in.Tp != nil {
 		SetObjectDefaults_Tpointer(in.Tp)
 	}
 }
+
+// SetObjectDefaultsWithRecorder_Ttest is like SetObjectDefaults_Ttest,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_Ttest(in *Ttest, recorder DefaultsRecorder) {
+	SetObjectDefaultsWithRecorder_Tpointer(&in.NTP, prefixedDefaultsRecorder{prefix: "NTP", recorder: recorder})
+	if in.Tp != nil {
+		SetObjectDefaultsWithRecorder_Tpointer(in.Tp, prefixedDefaultsRecorder{prefix: "Tp", recorder: recorder})
+	}
+}
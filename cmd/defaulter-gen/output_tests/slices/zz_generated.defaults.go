@@ -22,7 +22,10 @@ limitations under the License.
 package slices
 
 import (
+	fmt "fmt"
+
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // RegisterDefaults adds defaulters functions to the given scheme.
@@ -35,10 +38,78 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	return nil
 }
 
+// KindDefaulters are the object defaulters of the kinds of this group
+// version, for applying defaults without constructing a scheme. They
+// return false if obj is not a pointer to the type of their kind.
+var KindDefaulters = map[schema.GroupVersionKind]func(obj interface{}) bool{
+	{Group: "output_tests", Version: "slices", Kind: "Ttest"}: func(obj interface{}) bool {
+		in, ok := obj.(*Ttest)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_Ttest(in)
+		return true
+	},
+	{Group: "output_tests", Version: "slices", Kind: "TtestList"}: func(obj interface{}) bool {
+		in, ok := obj.(*TtestList)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_TtestList(in)
+		return true
+	},
+	{Group: "output_tests", Version: "slices", Kind: "TtestPointerList"}: func(obj interface{}) bool {
+		in, ok := obj.(*TtestPointerList)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_TtestPointerList(in)
+		return true
+	},
+}
+
+// SetObjectDefaultsForKind applies the object defaulter of the given kind to
+// obj, which must be a pointer to the type of the kind. It returns false if
+// the kind has no object defaulter in this group version, or obj is not of
+// its type.
+func SetObjectDefaultsForKind(gvk schema.GroupVersionKind, obj interface{}) bool {
+	defaulter, ok := KindDefaulters[gvk]
+	if !ok {
+		return false
+	}
+	return defaulter(obj)
+}
+
+// DefaultsRecorder is notified of the defaults applied by the
+// SetObjectDefaultsWithRecorder_ functions.
+type DefaultsRecorder interface {
+	// RecordDefault is called with the JSON path of a field, relative to the
+	// defaulted object, after a default declared by a marker was applied to it.
+	// Defaults applied by SetDefaults_ functions are not recorded.
+	RecordDefault(path string)
+}
+
+// prefixedDefaultsRecorder records the defaults of a nested object under the
+// path of the object.
+type prefixedDefaultsRecorder struct {
+	prefix   string
+	recorder DefaultsRecorder
+}
+
+func (r prefixedDefaultsRecorder) RecordDefault(path string) {
+	r.recorder.RecordDefault(r.prefix + "." + path)
+}
+
 func SetObjectDefaults_Ttest(in *Ttest) {
 	SetDefaults_Ttest(in)
 }
 
+// SetObjectDefaultsWithRecorder_Ttest is like SetObjectDefaults_Ttest,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_Ttest(in *Ttest, recorder DefaultsRecorder) {
+	SetDefaults_Ttest(in)
+}
+
 func SetObjectDefaults_TtestList(in *TtestList) {
 	for i := range in.Items {
 		a := &in.Items[i]
@@ -46,6 +117,15 @@ func SetObjectDefaults_TtestList(in *TtestList) {
 	}
 }
 
+// SetObjectDefaultsWithRecorder_TtestList is like SetObjectDefaults_TtestList,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_TtestList(in *TtestList, recorder DefaultsRecorder) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaultsWithRecorder_Ttest(a, prefixedDefaultsRecorder{prefix: fmt.Sprintf("Items[%d]", i), recorder: recorder})
+	}
+}
+
 func SetObjectDefaults_TtestPointerList(in *TtestPointerList) {
 	for i := range in.Items {
 		a := in.Items[i]
@@ -54,3 +134,14 @@ func SetObjectDefaults_TtestPointerList(in *TtestPointerList) {
 		}
 	}
 }
+
+// SetObjectDefaultsWithRecorder_TtestPointerList is like SetObjectDefaults_TtestPointerList,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_TtestPointerList(in *TtestPointerList, recorder DefaultsRecorder) {
+	for i := range in.Items {
+		a := in.Items[i]
+		if a != nil {
+			SetObjectDefaultsWithRecorder_Ttest(a, prefixedDefaultsRecorder{prefix: fmt.Sprintf("Items[%d]", i), recorder: recorder})
+		}
+	}
+}
@@ -22,7 +22,10 @@ limitations under the License.
 package wholepkg
 
 import (
+	fmt "fmt"
+
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // RegisterDefaults adds defaulters functions to the given scheme.
@@ -37,11 +40,96 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	return nil
 }
 
+// KindDefaulters are the object defaulters of the kinds of this group
+// version, for applying defaults without constructing a scheme. They
+// return false if obj is not a pointer to the type of their kind.
+var KindDefaulters = map[schema.GroupVersionKind]func(obj interface{}) bool{
+	{Group: "output_tests", Version: "wholepkg", Kind: "StructEverything"}: func(obj interface{}) bool {
+		in, ok := obj.(*StructEverything)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_StructEverything(in)
+		return true
+	},
+	{Group: "output_tests", Version: "wholepkg", Kind: "StructPointer"}: func(obj interface{}) bool {
+		in, ok := obj.(*StructPointer)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_StructPointer(in)
+		return true
+	},
+	{Group: "output_tests", Version: "wholepkg", Kind: "StructPrimitives"}: func(obj interface{}) bool {
+		in, ok := obj.(*StructPrimitives)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_StructPrimitives(in)
+		return true
+	},
+	{Group: "output_tests", Version: "wholepkg", Kind: "StructSlices"}: func(obj interface{}) bool {
+		in, ok := obj.(*StructSlices)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_StructSlices(in)
+		return true
+	},
+	{Group: "output_tests", Version: "wholepkg", Kind: "StructStructPrimitives"}: func(obj interface{}) bool {
+		in, ok := obj.(*StructStructPrimitives)
+		if !ok {
+			return false
+		}
+		SetObjectDefaults_StructStructPrimitives(in)
+		return true
+	},
+}
+
+// SetObjectDefaultsForKind applies the object defaulter of the given kind to
+// obj, which must be a pointer to the type of the kind. It returns false if
+// the kind has no object defaulter in this group version, or obj is not of
+// its type.
+func SetObjectDefaultsForKind(gvk schema.GroupVersionKind, obj interface{}) bool {
+	defaulter, ok := KindDefaulters[gvk]
+	if !ok {
+		return false
+	}
+	return defaulter(obj)
+}
+
+// DefaultsRecorder is notified of the defaults applied by the
+// SetObjectDefaultsWithRecorder_ functions.
+type DefaultsRecorder interface {
+	// RecordDefault is called with the JSON path of a field, relative to the
+	// defaulted object, after a default declared by a marker was applied to it.
+	// Defaults applied by SetDefaults_ functions are not recorded.
+	RecordDefault(path string)
+}
+
+// prefixedDefaultsRecorder records the defaults of a nested object under the
+// path of the object.
+type prefixedDefaultsRecorder struct {
+	prefix   string
+	recorder DefaultsRecorder
+}
+
+func (r prefixedDefaultsRecorder) RecordDefault(path string) {
+	r.recorder.RecordDefault(r.prefix + "." + path)
+}
+
 func SetObjectDefaults_StructEverything(in *StructEverything) {
 	SetObjectDefaults_StructPointer(&in.PointerStructField)
 	SetObjectDefaults_StructSlices(&in.SlicesStructField)
 }
 
+// SetObjectDefaultsWithRecorder_StructEverything is like SetObjectDefaults_StructEverything,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_StructEverything(in *StructEverything, recorder DefaultsRecorder) {
+	SetObjectDefaultsWithRecorder_StructPointer(&in.PointerStructField, prefixedDefaultsRecorder{prefix: "PointerStructField", recorder: recorder})
+	SetObjectDefaultsWithRecorder_StructSlices(&in.SlicesStructField, prefixedDefaultsRecorder{prefix: "SlicesStructField", recorder: recorder})
+}
+
 func SetObjectDefaults_StructPointer(in *StructPointer) {
 	SetObjectDefaults_StructPrimitives(&in.PointerStructPrimitivesField)
 	if in.PointerPointerStructPrimitivesField != nil {
@@ -53,10 +141,29 @@ func SetObjectDefaults_StructPointer(in *StructPointer) {
 	}
 }
 
+// SetObjectDefaultsWithRecorder_StructPointer is like SetObjectDefaults_StructPointer,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_StructPointer(in *StructPointer, recorder DefaultsRecorder) {
+	SetObjectDefaultsWithRecorder_StructPrimitives(&in.PointerStructPrimitivesField, prefixedDefaultsRecorder{prefix: "PointerStructPrimitivesField", recorder: recorder})
+	if in.PointerPointerStructPrimitivesField != nil {
+		SetObjectDefaultsWithRecorder_StructPrimitives(in.PointerPointerStructPrimitivesField, prefixedDefaultsRecorder{prefix: "PointerPointerStructPrimitivesField", recorder: recorder})
+	}
+	SetObjectDefaultsWithRecorder_StructStructPrimitives(&in.PointerStructStructPrimitives, prefixedDefaultsRecorder{prefix: "PointerStructStructPrimitives", recorder: recorder})
+	if in.PointerPointerStructStructPrimitives != nil {
+		SetObjectDefaultsWithRecorder_StructStructPrimitives(in.PointerPointerStructStructPrimitives, prefixedDefaultsRecorder{prefix: "PointerPointerStructStructPrimitives", recorder: recorder})
+	}
+}
+
 func SetObjectDefaults_StructPrimitives(in *StructPrimitives) {
 	SetDefaults_StructPrimitives(in)
 }
 
+// SetObjectDefaultsWithRecorder_StructPrimitives is like SetObjectDefaults_StructPrimitives,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_StructPrimitives(in *StructPrimitives, recorder DefaultsRecorder) {
+	SetDefaults_StructPrimitives(in)
+}
+
 func SetObjectDefaults_StructSlices(in *StructSlices) {
 	for i := range in.SliceStructPrimitivesField {
 		a := &in.SliceStructPrimitivesField[i]
@@ -80,6 +187,37 @@ func SetObjectDefaults_StructSlices(in *StructSlices) {
 	}
 }
 
+// SetObjectDefaultsWithRecorder_StructSlices is like SetObjectDefaults_StructSlices,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_StructSlices(in *StructSlices, recorder DefaultsRecorder) {
+	for i := range in.SliceStructPrimitivesField {
+		a := &in.SliceStructPrimitivesField[i]
+		SetObjectDefaultsWithRecorder_StructPrimitives(a, prefixedDefaultsRecorder{prefix: fmt.Sprintf("SliceStructPrimitivesField[%d]", i), recorder: recorder})
+	}
+	for i := range in.SlicePointerStructPrimitivesField {
+		a := in.SlicePointerStructPrimitivesField[i]
+		if a != nil {
+			SetObjectDefaultsWithRecorder_StructPrimitives(a, prefixedDefaultsRecorder{prefix: fmt.Sprintf("SlicePointerStructPrimitivesField[%d]", i), recorder: recorder})
+		}
+	}
+	for i := range in.SliceStructStructPrimitives {
+		a := &in.SliceStructStructPrimitives[i]
+		SetObjectDefaultsWithRecorder_StructStructPrimitives(a, prefixedDefaultsRecorder{prefix: fmt.Sprintf("SliceStructStructPrimitives[%d]", i), recorder: recorder})
+	}
+	for i := range in.SlicePointerStructStructPrimitives {
+		a := in.SlicePointerStructStructPrimitives[i]
+		if a != nil {
+			SetObjectDefaultsWithRecorder_StructStructPrimitives(a, prefixedDefaultsRecorder{prefix: fmt.Sprintf("SlicePointerStructStructPrimitives[%d]", i), recorder: recorder})
+		}
+	}
+}
+
 func SetObjectDefaults_StructStructPrimitives(in *StructStructPrimitives) {
 	SetObjectDefaults_StructPrimitives(&in.StructField)
 }
+
+// SetObjectDefaultsWithRecorder_StructStructPrimitives is like SetObjectDefaults_StructStructPrimitives,
+// but reports the paths of the fields defaulted by markers to recorder.
+func SetObjectDefaultsWithRecorder_StructStructPrimitives(in *StructStructPrimitives, recorder DefaultsRecorder) {
+	SetObjectDefaultsWithRecorder_StructPrimitives(&in.StructField, prefixedDefaultsRecorder{prefix: "StructField", recorder: recorder})
+}
@@ -15,7 +15,53 @@ limitations under the License.
 */
 
 // go-to-protobuf generates a Protobuf IDL from a Go struct, respecting any
-// existing IDL tags on the Go struct.
+// existing IDL tags on the Go struct. The doc comments of the Go package, types
+// and fields are carried over to the IDL, as the comments of the file, messages
+// and fields.
+//
+// Go interfaces tagged with "+protobuf.service=true" are generated as gRPC
+// services, whose rpcs are the methods of the interface. The methods must be
+// of the form Method(context.Context, *Request) (*Response, error), where
+// Request and Response are generated messages. The gRPC client and server of
+// the packages holding services are generated along with their messages.
+//
+// The members of a union, i.e. the fields tagged with "+unionMember" or
+// "+k8s:unionMember", are generated as a oneof named "union", unless named
+// with "+protobuf.oneof=<name>" on the struct. The members cannot be repeated
+// or maps. Their encoding is unchanged, so the oneof is only generated in the
+// IDL not compiled by protoc-gen-gogo, i.e. with --only-idl or the IDL without
+// gogoprotobuf extensions, the Go code generated from a oneof not matching the
+// struct.
+//
+// Fields are numbered after their protobuf struct tags, which are written back
+// to the Go structs, or in Go order. With --field-numbers-file, the numbers
+// are also recorded in a lock file, along with the numbers of the removed
+// fields, so that fields without struct tags keep their numbers whatever the
+// order of the Go fields, and generation fails if a field would be renumbered
+// or reuse the number of another field. The lock file is only updated once
+// the generation succeeded, including the compilation of the IDL.
+//
+// With --time-as-timestamp, metav1.Time and metav1.MicroTime fields are
+// generated as google.protobuf.Timestamp, which they are encoded like, and
+// with --duration-as-duration, metav1.Duration fields as
+// google.protobuf.Duration, which requires --only-idl since the encoding
+// differs. Unexported converters between the Go types used by the generated
+// messages and the well-known types, e.g. timeToTimestamp, are generated into
+// generated.wellknowntypes.go in the IDL not compiled by protoc-gen-gogo, i.e.
+// with --only-idl or the IDL without gogoprotobuf extensions.
+//
+// The Go code of the IDL is generated with protoc and protoc-gen-gogo, which
+// must be in the PATH, unless --builtin-compiler is set: the IDL is then
+// compiled by a pure Go compiler, and the code generated in process. gogo.proto
+// and the standard imports are then resolved in process too, so that no
+// --proto-import is needed for them.
+//
+// The IDL and Go code of each package are generated into the directory of the
+// package, as resolved from the module graph, and the IDL of the packages it
+// imports is found in their directories, so the packages need not live under
+// --output-dir, e.g. in nested modules or with vanity import paths. go_package
+// options name the Go package when it differs from the last element of its
+// path, e.g. example.com/widgets/v2;widgets.
 package main
 
 import (
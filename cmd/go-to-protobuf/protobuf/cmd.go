@@ -22,6 +22,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"sort"
@@ -48,6 +49,12 @@ type Generator struct {
 	KeepGogoproto        bool
 	SkipGeneratedRewrite bool
 	DropEmbeddedFields   string
+	Syntax               string
+	Proto3Presence       string
+	BuiltinCompiler      bool
+	FieldNumbersFile     string
+	TimeAsTimestamp      bool
+	DurationAsDuration   bool
 }
 
 func New() *Generator {
@@ -65,6 +72,8 @@ func New() *Generator {
 		}, ","),
 		Packages:           "",
 		DropEmbeddedFields: "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta",
+		Syntax:             syntaxProto2,
+		Proto3Presence:     presenceWrappers,
 	}
 }
 
@@ -72,7 +81,7 @@ func (g *Generator) BindFlags(flag *flag.FlagSet) {
 	flag.StringVarP(&g.GoHeaderFile, "go-header-file", "h", "", "File containing boilerplate header text. The string YEAR will be replaced with the current 4-digit year.")
 	flag.StringVarP(&g.Packages, "packages", "p", g.Packages, "comma-separated list of directories to get input types from. Directories prefixed with '-' are not generated, directories prefixed with '+' only create types with explicit IDL instructions.")
 	flag.StringVar(&g.APIMachineryPackages, "apimachinery-packages", g.APIMachineryPackages, "comma-separated list of directories to get apimachinery input types from which are needed by any API. Directories prefixed with '-' are not generated, directories prefixed with '+' only create types with explicit IDL instructions.")
-	flag.StringVar(&g.OutputDir, "output-dir", g.OutputDir, "The base directory under which to generate results.")
+	flag.StringVar(&g.OutputDir, "output-dir", g.OutputDir, "The base directory searched for imported protobufs, after the directories of the packages.")
 	flag.StringSliceVar(&g.ProtoImport, "proto-import", g.ProtoImport, "A search path for imported protobufs (may be repeated).")
 	flag.StringVar(&g.Conditional, "conditional", g.Conditional, "An optional Golang build tag condition to add to the generated Go code")
 	flag.BoolVar(&g.Clean, "clean", g.Clean, "If true, remove all generated files for the specified Packages.")
@@ -80,6 +89,12 @@ func (g *Generator) BindFlags(flag *flag.FlagSet) {
 	flag.BoolVar(&g.KeepGogoproto, "keep-gogoproto", g.KeepGogoproto, "If true, the generated IDL will contain gogoprotobuf extensions which are normally removed")
 	flag.BoolVar(&g.SkipGeneratedRewrite, "skip-generated-rewrite", g.SkipGeneratedRewrite, "If true, skip fixing up the generated.pb.go file (debugging only).")
 	flag.StringVar(&g.DropEmbeddedFields, "drop-embedded-fields", g.DropEmbeddedFields, "Comma-delimited list of embedded Go types to omit from generated protobufs")
+	flag.StringVar(&g.Syntax, "syntax", g.Syntax, "The syntax of the generated IDL, proto2, proto3 or editions, which all encode the messages alike, e.g. with unpacked repeated scalars. editions generates the IDL in edition 2023 without gogoprotobuf extensions, for protoc 27.0 or newer and toolchains other than protoc-gen-gogo, which requires --only-idl.")
+	flag.StringVar(&g.Proto3Presence, "proto3-presence", g.Proto3Presence, "How the presence of nullable scalar fields, e.g. pointers, is declared in proto3: wrappers, wrapping them into the messages of google/protobuf/wrappers.proto, or optional, labelling them as proto3 optional fields, which requires --only-idl as protoc-gen-gogo does not support them.")
+	flag.StringVar(&g.FieldNumbersFile, "field-numbers-file", g.FieldNumbersFile, "The path of a lock file of the field numbers of the generated messages, updated by each successful run. Generation fails if a field would be renumbered or reuse the number of another field, e.g. a removed one, which would break wire compatibility.")
+	flag.BoolVar(&g.TimeAsTimestamp, "time-as-timestamp", g.TimeAsTimestamp, "If true, the fields of type metav1.Time and metav1.MicroTime are declared as google.protobuf.Timestamp, which they are encoded like, in the IDL not compiled by protoc-gen-gogo, and converters between them are generated into generated.wellknowntypes.go.")
+	flag.BoolVar(&g.DurationAsDuration, "duration-as-duration", g.DurationAsDuration, "If true, the fields of type metav1.Duration are declared as google.protobuf.Duration, and converters between them are generated into generated.wellknowntypes.go. As metav1.Duration is encoded as nanoseconds, this requires --only-idl.")
+	flag.BoolVar(&g.BuiltinCompiler, "builtin-compiler", g.BuiltinCompiler, "If true, compile the IDL and generate the gogoprotobuf Go code in process, without the protoc and protoc-gen-gogo binaries, nor the gogo.proto and standard imports on disk.")
 }
 
 // This roughly models gengo/v2.Execute.
@@ -98,6 +113,18 @@ func Run(g *Generator) {
 	if len(allInputs) == 0 {
 		log.Fatalf("Both apimachinery-packages and packages are empty. At least one package must be specified.")
 	}
+	switch {
+	case g.Syntax != syntaxProto2 && g.Syntax != syntaxProto3 && g.Syntax != syntaxEditions:
+		log.Fatalf("Unsupported syntax %q, must be %s, %s or %s.", g.Syntax, syntaxProto2, syntaxProto3, syntaxEditions)
+	case g.Proto3Presence != presenceWrappers && g.Proto3Presence != presenceOptional:
+		log.Fatalf("Unsupported proto3 presence %q, must be %s or %s.", g.Proto3Presence, presenceWrappers, presenceOptional)
+	case g.Syntax == syntaxProto3 && g.Proto3Presence == presenceOptional && !g.OnlyIDL:
+		log.Fatalf("proto3 optional fields are not supported by protoc-gen-gogo, use --proto3-presence=%s or --only-idl.", presenceWrappers)
+	case g.Syntax == syntaxEditions && !g.OnlyIDL:
+		log.Fatalf("editions are not supported by protoc-gen-gogo, use --only-idl.")
+	case g.DurationAsDuration && !g.OnlyIDL:
+		log.Fatalf("metav1.Duration is not encoded like google.protobuf.Duration, --duration-as-duration requires --only-idl.")
+	}
 
 	// Build up a list of packages to load from all the inputs.  Track the
 	// special modifiers for each.  NOTE: This does not support pkg/... syntax.
@@ -148,7 +175,7 @@ func Run(g *Generator) {
 		log.Fatalf("Failed making a context: %v", err)
 	}
 
-	c.FileTypes["protoidl"] = NewProtoFile()
+	c.FileTypes["protoidl"] = NewProtoFileForSyntax(g.Syntax)
 
 	// Roughly models gengo/v2.Execute calling the
 	// tool-provided Targets() callback.
@@ -157,6 +184,11 @@ func Run(g *Generator) {
 	if err != nil {
 		log.Fatalf("Failed loading boilerplate (consider using the go-header-file flag): %v", err)
 	}
+	goBoilerplate, err := gengo.GoBoilerplate(g.GoHeaderFile, "", gengo.StdGeneratedBy)
+	if err != nil {
+		log.Fatalf("Failed loading boilerplate (consider using the go-header-file flag): %v", err)
+	}
+	wellKnown := wellKnownTypes(g.TimeAsTimestamp, g.DurationAsDuration)
 
 	omitTypes := map[types.Name]struct{}{}
 	for _, t := range strings.Split(g.DropEmbeddedFields, ",") {
@@ -172,6 +204,13 @@ func Run(g *Generator) {
 		omitTypes[name] = struct{}{}
 	}
 
+	var fieldNumbers *fieldNumberLock
+	if len(g.FieldNumbersFile) > 0 {
+		if fieldNumbers, err = loadFieldNumberLock(g.FieldNumbersFile); err != nil {
+			log.Fatalf("Unable to load the field numbers: %v", err)
+		}
+	}
+
 	protobufNames := NewProtobufNamer()
 	outputPackages := []generator.Target{}
 	nonOutputPackages := map[string]struct{}{}
@@ -183,6 +222,16 @@ func Run(g *Generator) {
 		}
 		pkg := c.Universe[input]
 		protopkg := newProtobufPackage(pkg.Path, pkg.Dir, mod.name, mod.allTypes, omitTypes)
+		protopkg.GoName = pkg.Name
+		protopkg.DocComments = pkg.DocComments
+		protopkg.Syntax = g.Syntax
+		protopkg.Proto3Presence = g.Proto3Presence
+		protopkg.OmitGogo = g.Syntax == syntaxEditions
+		protopkg.Oneofs = g.OnlyIDL
+		protopkg.FieldNumbers = fieldNumbers
+		if g.OnlyIDL {
+			protopkg.WellKnownTypes = wellKnown
+		}
 		header := append([]byte{}, boilerplate...)
 		header = append(header, protopkg.HeaderComment...)
 		protopkg.HeaderComment = header
@@ -217,13 +266,14 @@ func Run(g *Generator) {
 	}
 	sort.Sort(positionOrder{topologicalPos, protobufNames.packages})
 
-	var localOutputPackages []generator.Target
+	var localOutputPackages, wellKnownConverters []generator.Target
 	for _, p := range protobufNames.packages {
 		if _, ok := nonOutputPackages[p.Name()]; ok {
 			// if we're not outputting the package, don't include it in either package list
 			continue
 		}
 		localOutputPackages = append(localOutputPackages, p)
+		wellKnownConverters = append(wellKnownConverters, p.WellKnownConverters(goBoilerplate))
 	}
 
 	if err := protobufNames.AssignTypesToPackages(c); err != nil {
@@ -233,33 +283,66 @@ func Run(g *Generator) {
 	if err := c.ExecuteTargets(localOutputPackages); err != nil {
 		log.Fatalf("Failed executing local generator: %v", err)
 	}
+	if len(wellKnown) > 0 && g.OnlyIDL {
+		if err := c.ExecuteTargets(wellKnownConverters); err != nil {
+			log.Fatalf("Failed executing well-known type converters generator: %v", err)
+		}
+	}
 
+	if fieldNumbers != nil {
+		// lock the field numbers only once the code is generated, on any of
+		// the returns below: log.Fatalf exits without running deferred calls.
+		defer func() {
+			if err := fieldNumbers.write(); err != nil {
+				log.Fatalf("Unable to write the field numbers: %v", err)
+			}
+		}()
+	}
+
+	minimum := minimumProtocVersion(g.Syntax, g.Proto3Presence)
 	if g.OnlyIDL {
+		// the IDL is compiled by another toolchain, warn if the installed
+		// protoc would not compile it.
+		if version, err := installedProtocVersion(); err == nil && !version.AtLeast(minimum) {
+			log.Printf("The generated IDL requires protoc %s or newer, found %s.", minimum, version)
+		}
 		return
 	}
 
-	if _, err := exec.LookPath("protoc"); err != nil {
-		log.Fatalf("Unable to find 'protoc': %v", err)
+	if !g.BuiltinCompiler {
+		version, err := installedProtocVersion()
+		if err != nil {
+			log.Fatalf("Unable to find 'protoc': %v", err)
+		}
+		if !version.AtLeast(minimum) {
+			log.Fatalf("The generated IDL requires protoc %s or newer, found %s.", minimum, version)
+		}
 	}
 
-	searchArgs := []string{"-I", ".", "-I", g.OutputDir}
-	if len(g.ProtoImport) != 0 {
-		for _, s := range g.ProtoImport {
-			searchArgs = append(searchArgs, "-I", s)
+	// map the virtual directories of the IDL of the packages to their
+	// directories, which need not be under the output dir, e.g. in the module
+	// cache, in nested modules or with vanity import paths.
+	importPaths := []string{}
+	for _, p := range protobufNames.packages {
+		if len(p.Dir()) > 0 {
+			importPaths = append(importPaths, p.Path()+"="+p.Dir())
 		}
 	}
-	// Despite docs saying that `--gogo_out=paths=source_relative:.` will
-	// output the .pb.go file to the same directory as the .proto file, it
-	// doesn't. Given example.com/foo/bar.proto (found in one of the -I paths
-	// above), the output becomes
-	// $output_base/example.com/foo/example.com/foo/bar.pb.go - basically
-	// useless.  Users should set the output-dir to a single dir under which
-	// all the packages in question live (e.g. staging/src in kubernetes).
-	// Alternately, we could generate into a temp path and then move the
-	// resulting file back to the input dir, but that seems brittle in other
-	// ways.
-	args := searchArgs
-	args = append(args, fmt.Sprintf("--gogo_out=%s", g.OutputDir))
+	importPaths = append(importPaths, ".", g.OutputDir)
+	importPaths = append(importPaths, g.ProtoImport...)
+	searchArgs := []string{}
+	for _, s := range importPaths {
+		searchArgs = append(searchArgs, "-I", s)
+	}
+	// The Go code is generated under the virtual directory of the IDL, e.g.
+	// $gogo_out/example.com/foo/generated.pb.go for example.com/foo, which is
+	// not the directory of the package in module mode. Generate it into a
+	// temporary directory and move it next to the IDL instead.
+	gogoOut, err := os.MkdirTemp("", "go-to-protobuf")
+	if err != nil {
+		log.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(gogoOut)
 
 	buf := &bytes.Buffer{}
 	if len(g.Conditional) > 0 {
@@ -270,16 +353,35 @@ func Run(g *Generator) {
 	for _, outputPackage := range outputPackages {
 		p := outputPackage.(*protobufPackage)
 
-		path := filepath.Join(g.OutputDir, p.ImportPath())
-		outputPath := filepath.Join(g.OutputDir, p.OutputPath())
+		path := filepath.Join(p.Dir(), filepath.Base(p.ImportPath()))
+		outputPath := filepath.Join(p.Dir(), filepath.Base(p.OutputPath()))
 
-		// generate the gogoprotobuf protoc
-		cmd := exec.Command("protoc", append(args, path)...)
-		out, err := cmd.CombinedOutput()
-		if err != nil {
-			log.Println(strings.Join(cmd.Args, " "))
-			log.Println(string(out))
-			log.Fatalf("Unable to run protoc on %s: %v", p.Name(), err)
+		// generate the gogoprotobuf protoc, with the gRPC stubs of the services
+		if g.BuiltinCompiler {
+			if err := compileBuiltin(importPaths, p.ImportPath(), gogoOut); err != nil {
+				log.Fatalf("Unable to compile %s: %v", p.Name(), err)
+			}
+		} else {
+			parameter := ""
+			if hasServices(c.Universe[p.Path()]) {
+				parameter = "plugins=grpc"
+			}
+			args := append([]string{}, searchArgs...)
+			if len(parameter) > 0 {
+				args = append(args, fmt.Sprintf("--gogo_out=%s:%s", parameter, gogoOut))
+			} else {
+				args = append(args, fmt.Sprintf("--gogo_out=%s", gogoOut))
+			}
+			cmd := exec.Command("protoc", append(args, path)...)
+			out, err := cmd.CombinedOutput()
+			if err != nil {
+				log.Println(strings.Join(cmd.Args, " "))
+				log.Println(string(out))
+				log.Fatalf("Unable to run protoc on %s: %v", p.Name(), err)
+			}
+		}
+		if err := moveFile(filepath.Join(gogoOut, p.OutputPath()), outputPath); err != nil {
+			log.Fatalf("Unable to move the generated %s: %v", outputPath, err)
 		}
 
 		if g.SkipGeneratedRewrite {
@@ -293,8 +395,8 @@ func Run(g *Generator) {
 		}
 
 		// sort imports
-		cmd = exec.Command("goimports", "-w", outputPath)
-		out, err = cmd.CombinedOutput()
+		cmd := exec.Command("goimports", "-w", outputPath)
+		out, err := cmd.CombinedOutput()
 		if len(out) > 0 {
 			log.Print(string(out))
 		}
@@ -324,10 +426,17 @@ func Run(g *Generator) {
 		for _, outputPackage := range outputPackages {
 			p := outputPackage.(*protobufPackage)
 			p.OmitGogo = true
+			p.Oneofs = true
+			p.WellKnownTypes = wellKnown
 		}
 		if err := c.ExecuteTargets(localOutputPackages); err != nil {
 			log.Fatalf("Failed executing local generator: %v", err)
 		}
+		if len(wellKnown) > 0 {
+			if err := c.ExecuteTargets(wellKnownConverters); err != nil {
+				log.Fatalf("Failed executing well-known type converters generator: %v", err)
+			}
+		}
 	}
 
 	for _, outputPackage := range outputPackages {
@@ -337,7 +446,7 @@ func Run(g *Generator) {
 			continue
 		}
 
-		pattern := filepath.Join(g.OutputDir, p.Path(), "*.go")
+		pattern := filepath.Join(p.Dir(), "*.go")
 		files, err := filepath.Glob(pattern)
 		if err != nil {
 			log.Fatalf("Can't glob pattern %q: %v", pattern, err)
@@ -354,6 +463,18 @@ func Run(g *Generator) {
 	}
 }
 
+// moveFile moves a file, possibly across file systems.
+func moveFile(from, to string) error {
+	data, err := os.ReadFile(from)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(to, data, 0644); err != nil {
+		return err
+	}
+	return os.Remove(from)
+}
+
 func deps(c *generator.Context, pkgs []*protobufPackage) map[string][]string {
 	ret := map[string][]string{}
 	for _, p := range pkgs {
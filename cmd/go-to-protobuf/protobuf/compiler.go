@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	// gogoproto registers the descriptor of gogo.proto.
+	_ "github.com/gogo/protobuf/gogoproto"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	// command registers the plugins of protoc-gen-gogo.
+	_ "github.com/gogo/protobuf/vanity/command"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// gogoProtoPath is the path the IDL imports the gogoprotobuf extensions from.
+const gogoProtoPath = "github.com/gogo/protobuf/gogoproto/gogo.proto"
+
+// gogoProtoDescriptor returns the descriptor of the gogoprotobuf extensions
+// registered by the gogoproto package, named after gogoProtoPath.
+func gogoProtoDescriptor() (*descriptorpb.FileDescriptorProto, error) {
+	gz := gogoproto.FileDescriptor("gogo.proto")
+	if gz == nil {
+		return nil, fmt.Errorf("gogo.proto is not registered")
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	fdp := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(data, fdp); err != nil {
+		return nil, err
+	}
+	fdp.Name = proto.String(gogoProtoPath)
+	return fdp, nil
+}
+
+// sourceResolver resolves the IDL files like protoc with the given import
+// paths, which may map a virtual directory to a directory on disk, e.g.
+// k8s.io/api/core/v1=/src/api/core/v1. The gogoprotobuf extensions, and the
+// standard imports, are resolved in process if not found in the import paths.
+func sourceResolver(importPaths []string) protocompile.Resolver {
+	var resolvers protocompile.CompositeResolver
+	for _, p := range importPaths {
+		virtual, dir, mapped := strings.Cut(p, "=")
+		if !mapped {
+			resolvers = append(resolvers, &protocompile.SourceResolver{ImportPaths: []string{p}})
+			continue
+		}
+		prefix := virtual + "/"
+		resolvers = append(resolvers, &protocompile.SourceResolver{
+			Accessor: func(name string) (io.ReadCloser, error) {
+				if !strings.HasPrefix(name, prefix) {
+					return nil, os.ErrNotExist
+				}
+				return os.Open(filepath.Join(dir, strings.TrimPrefix(name, prefix)))
+			},
+		})
+	}
+	resolvers = append(resolvers, protocompile.ResolverFunc(func(name string) (protocompile.SearchResult, error) {
+		if name != gogoProtoPath {
+			return protocompile.SearchResult{}, os.ErrNotExist
+		}
+		fdp, err := gogoProtoDescriptor()
+		if err != nil {
+			return protocompile.SearchResult{}, err
+		}
+		return protocompile.SearchResult{Proto: fdp}, nil
+	}))
+	return protocompile.WithStandardImports(resolvers)
+}
+
+// compileBuiltin generates the gogoprotobuf Go code of the IDL file, found in
+// one of the import paths, into outputDir, like protoc with protoc-gen-gogo
+// would, with the gRPC stubs of its services, but without them: the IDL is
+// compiled with a pure Go compiler, and the code generated in process.
+func compileBuiltin(importPaths []string, file, outputDir string) error {
+	compiler := protocompile.Compiler{
+		Resolver:       sourceResolver(importPaths),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	files, err := compiler.Compile(context.Background(), file)
+	if err != nil {
+		return err
+	}
+
+	// like protoc, pass the file and all its dependencies, in import order,
+	// with the source info of the file only.
+	request := &plugin.CodeGeneratorRequest{FileToGenerate: []string{file}}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor) error
+	add = func(fd protoreflect.FileDescriptor) error {
+		if seen[fd.Path()] {
+			return nil
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			if err := add(imports.Get(i).FileDescriptor); err != nil {
+				return err
+			}
+		}
+		fdp := protodesc.ToFileDescriptorProto(fd)
+		if fd.Path() != file {
+			fdp.SourceCodeInfo = nil
+		}
+		data, err := proto.Marshal(fdp)
+		if err != nil {
+			return err
+		}
+		// protoc-gen-gogo has its own descriptor types, with the same wire
+		// format.
+		gogoFdp := &descriptor.FileDescriptorProto{}
+		if err := gogoproto.Unmarshal(data, gogoFdp); err != nil {
+			return err
+		}
+		request.ProtoFile = append(request.ProtoFile, gogoFdp)
+		return nil
+	}
+	if err := add(files[0]); err != nil {
+		return err
+	}
+
+	// The plugins of protoc-gen-gogo are global, and filtered in place by
+	// the parameter: the gRPC plugin, which generates nothing for the files
+	// without services, is always enabled so that it stays registered for the
+	// next files. Like our protoc-gen-gogo, generate the code next to the IDL.
+	parameter := "plugins=grpc"
+	if strings.Contains(file, "/") {
+		parameter += ",paths=source_relative"
+	}
+	request.Parameter = &parameter
+
+	// Like command.Generate, but without its test generation, which replaces
+	// the global plugins with its own.
+	g := generator.New()
+	g.Request = request
+	g.CommandLineParameters(parameter)
+	g.WrapTypes()
+	g.SetPackageNames()
+	g.BuildTypeNameMap()
+	g.GenerateAllFiles()
+	response := g.Response
+	if response.Error != nil {
+		return fmt.Errorf("%s", response.GetError())
+	}
+	for _, f := range response.File {
+		path := filepath.Join(outputDir, f.GetName())
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(f.GetContent()), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestCompileBuiltin(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the common package is in another module, mapped to its virtual path.
+	write("modules/common/generated.proto", `syntax = "proto2";
+package example.com.common;
+option go_package = "example.com/common";
+
+message Meta {
+  optional string name = 1;
+}
+`)
+	write("example.com/widgets/v1/generated.proto", `syntax = "proto2";
+package example.com.widgets.v1;
+import "example.com/common/generated.proto";
+import "google/protobuf/wrappers.proto";
+option go_package = "example.com/widgets/v1";
+
+// Widget is a widget.
+message Widget {
+  optional example.com.common.Meta metadata = 1;
+  optional google.protobuf.Int32Value replicas = 2;
+}
+
+service WidgetService {
+  rpc Get(Widget) returns (Widget);
+}
+`)
+	write("example.com/broken/generated.proto", `syntax = "proto2";
+package example.com.broken;
+import "example.com/missing/generated.proto";
+`)
+
+	importPaths := []string{"example.com/common=" + filepath.Join(dir, "modules/common"), dir}
+	if err := compileBuiltin(importPaths, "example.com/widgets/v1/generated.proto", dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "example.com/widgets/v1/generated.pb.go"))
+	if err != nil {
+		t.Fatalf("expected the generated code next to the IDL: %v", err)
+	}
+	for _, s := range []string{"type Widget struct", "*common.Meta", "type WidgetServiceClient interface"} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("expected the generated code to contain %q", s)
+		}
+	}
+
+	if err := compileBuiltin(importPaths, "example.com/broken/generated.proto", dir); err == nil {
+		t.Errorf("expected an error for a missing import, got none")
+	}
+}
+
+func TestCompileBuiltinGogoProto(t *testing.T) {
+	dir := t.TempDir()
+	// the IDL has the file options and field extensions of gogoprotobuf, and
+	// no import path leads to gogo.proto. The files are compiled in turn, like
+	// the packages of a run, the first without services.
+	idl := func(pkg, body string) string {
+		g := &genProtoIDL{localGoPackage: types.Name{Package: "example.com/" + pkg + "/v1", Name: "v1"}}
+		return `syntax = "proto2";
+package example.com.` + pkg + `.v1;
+import "github.com/gogo/protobuf/gogoproto/gogo.proto";
+` + strings.Join(g.PackageVars(nil), "\n") + "\n" + body
+	}
+	for _, tc := range []struct {
+		pkg, idl string
+		expect   []string
+	}{{
+		pkg: "gadgets",
+		idl: idl("gadgets", `
+message Gadget {
+  optional string name = 1 [(gogoproto.customname) = "Name", (gogoproto.nullable) = false];
+  repeated int32 ports = 2 [(gogoproto.casttype) = "Port"];
+}
+`),
+		expect: []string{`Name\s+string\s`, `Ports\s+\[\]Port\s`, `func \(m \*Gadget\) Marshal\(\)`},
+	}, {
+		pkg: "gizmos",
+		idl: idl("gizmos", `
+message Gizmo {
+  optional string name = 1 [(gogoproto.nullable) = false];
+}
+
+service GizmoService {
+  rpc Get(Gizmo) returns (Gizmo);
+}
+`),
+		expect: []string{`func \(m \*Gizmo\) Marshal\(\)`, `func \(this \*Gizmo\) String\(\)`, `type GizmoServiceClient interface`},
+	}} {
+		file := "example.com/" + tc.pkg + "/v1/generated.proto"
+		path := filepath.Join(dir, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(tc.idl), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := compileBuiltin([]string{dir}, file, dir); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.pkg, err)
+		}
+		data, err := os.ReadFile(filepath.Join(dir, "example.com", tc.pkg, "v1/generated.pb.go"))
+		if err != nil {
+			t.Fatalf("%s: expected the generated code next to the IDL: %v", tc.pkg, err)
+		}
+		for _, s := range tc.expect {
+			if !regexp.MustCompile(s).Match(data) {
+				t.Errorf("%s: expected the generated code to match %q", tc.pkg, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// syntaxEditions generates the IDL in edition 2023, for toolchains
+	// other than protoc-gen-gogo, which does not support editions.
+	syntaxEditions = "editions"
+	edition        = "2023"
+)
+
+// editionsFileOptions keep the encoding and validation of proto2 for the
+// fields of the IDL in editions, so that the messages stay wire compatible.
+var editionsFileOptions = []string{
+	"option features.repeated_field_encoding = EXPANDED;",
+	"option features.utf8_validation = NONE;",
+}
+
+// toEditionsField adapts a field generated for proto2 to editions, whose
+// fields have no optional or required labels: optional fields have explicit
+// presence by default, like in proto2, and required fields are declared with
+// the legacy required presence.
+func toEditionsField(field protoField) protoField {
+	field.Optional = false
+	if field.Required {
+		field.Required = false
+		extras := map[string]string{"features.field_presence": "LEGACY_REQUIRED"}
+		for k, v := range field.Extras {
+			extras[k] = v
+		}
+		field.Extras = extras
+	}
+	return field
+}
+
+// protocVersion is the version of protoc, e.g. 3.21 for "libprotoc 3.21.12"
+// or 27.1 for "libprotoc 27.1", protoc having dropped the leading 3 from its
+// version with 22.0.
+type protocVersion struct {
+	Major, Minor int
+}
+
+func (v protocVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+func (v protocVersion) AtLeast(o protocVersion) bool {
+	return v.Major > o.Major || (v.Major == o.Major && v.Minor >= o.Minor)
+}
+
+var protocVersionRegex = regexp.MustCompile(`^libprotoc (\d+)\.(\d+)`)
+
+// parseProtocVersion parses the output of protoc --version.
+func parseProtocVersion(out string) (protocVersion, error) {
+	m := protocVersionRegex.FindStringSubmatch(out)
+	if m == nil {
+		return protocVersion{}, fmt.Errorf("unrecognized protoc version %q", out)
+	}
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	return protocVersion{Major: major, Minor: minor}, nil
+}
+
+// minimumProtocVersion returns the oldest protoc able to compile the IDL in
+// the given syntax: proto3 optional fields require 3.15, and editions 27.0.
+func minimumProtocVersion(syntax, proto3Presence string) protocVersion {
+	switch {
+	case syntax == syntaxEditions:
+		return protocVersion{Major: 27}
+	case syntax == syntaxProto3 && proto3Presence == presenceOptional:
+		return protocVersion{Major: 3, Minor: 15}
+	default:
+		return protocVersion{Major: 3}
+	}
+}
+
+// installedProtocVersion returns the version of the protoc found in the PATH.
+func installedProtocVersion() (protocVersion, error) {
+	path, err := exec.LookPath("protoc")
+	if err != nil {
+		return protocVersion{}, err
+	}
+	out, err := exec.Command(path, "--version").CombinedOutput()
+	if err != nil {
+		return protocVersion{}, fmt.Errorf("unable to run %s --version: %v: %s", path, err, out)
+	}
+	return parseProtocVersion(string(out))
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestParseProtocVersion(t *testing.T) {
+	testcases := []struct {
+		Output    string
+		Expect    protocVersion
+		ExpectErr bool
+	}{
+		{Output: "libprotoc 3.21.12\n", Expect: protocVersion{Major: 3, Minor: 21}},
+		{Output: "libprotoc 27.1\n", Expect: protocVersion{Major: 27, Minor: 1}},
+		{Output: "protoc 27.1\n", ExpectErr: true},
+		{Output: "", ExpectErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Output, func(t *testing.T) {
+			version, err := parseProtocVersion(tc.Output)
+			if err != nil {
+				if !tc.ExpectErr {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.ExpectErr {
+				t.Fatalf("expected error, got none")
+			}
+			if version != tc.Expect {
+				t.Fatalf("expected %v, got %v", tc.Expect, version)
+			}
+		})
+	}
+}
+
+func TestMinimumProtocVersion(t *testing.T) {
+	testcases := []struct {
+		Syntax   string
+		Presence string
+		Version  protocVersion
+		Expect   bool
+	}{
+		{Syntax: syntaxProto2, Presence: presenceWrappers, Version: protocVersion{Major: 3, Minor: 21}, Expect: true},
+		{Syntax: syntaxProto3, Presence: presenceOptional, Version: protocVersion{Major: 3, Minor: 12}, Expect: false},
+		{Syntax: syntaxProto3, Presence: presenceOptional, Version: protocVersion{Major: 3, Minor: 15}, Expect: true},
+		{Syntax: syntaxEditions, Presence: presenceWrappers, Version: protocVersion{Major: 3, Minor: 21}, Expect: false},
+		{Syntax: syntaxEditions, Presence: presenceWrappers, Version: protocVersion{Major: 27}, Expect: true},
+		{Syntax: syntaxEditions, Presence: presenceWrappers, Version: protocVersion{Major: 28, Minor: 2}, Expect: true},
+	}
+
+	for _, tc := range testcases {
+		if got := tc.Version.AtLeast(minimumProtocVersion(tc.Syntax, tc.Presence)); got != tc.Expect {
+			t.Errorf("%s with %s presence on protoc %s: expected %t, got %t", tc.Syntax, tc.Presence, tc.Version, tc.Expect, got)
+		}
+	}
+}
+
+func TestToEditionsField(t *testing.T) {
+	scalar := &types.Type{Name: types.Name{Name: "string"}, Kind: types.Protobuf}
+
+	testcases := []struct {
+		Name   string
+		Field  protoField
+		Expect protoField
+	}{
+		{
+			Name:   "optional",
+			Field:  protoField{Name: "name", Type: scalar, Optional: true, Extras: map[string]string{"(gogoproto.nullable)": "false"}},
+			Expect: protoField{Name: "name", Type: scalar, Extras: map[string]string{"(gogoproto.nullable)": "false"}},
+		},
+		{
+			Name:   "required",
+			Field:  protoField{Name: "name", Type: scalar, Required: true},
+			Expect: protoField{Name: "name", Type: scalar, Extras: map[string]string{"features.field_presence": "LEGACY_REQUIRED"}},
+		},
+		{
+			Name:   "repeated",
+			Field:  protoField{Name: "names", Type: scalar, Repeated: true},
+			Expect: protoField{Name: "names", Type: scalar, Repeated: true},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if field := toEditionsField(tc.Field); !reflect.DeepEqual(field, tc.Expect) {
+				t.Fatalf("expected %#v, got %#v", tc.Expect, field)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+// fieldNumberLock records the numbers of the fields of the generated messages,
+// keyed by the full names of the messages, so that adding, removing or
+// reordering Go fields never renumbers protobuf fields.
+type fieldNumberLock struct {
+	path     string
+	messages map[string]*lockedFields
+}
+
+// lockedFields are the numbers of the fields of a message, keyed by their
+// names, and of its removed fields, which are never reused.
+type lockedFields struct {
+	Fields  map[string]int `json:"fields"`
+	Removed map[string]int `json:"removed,omitempty"`
+}
+
+// loadFieldNumberLock reads the lock file at path, if it exists.
+func loadFieldNumberLock(path string) (*fieldNumberLock, error) {
+	lock := &fieldNumberLock{path: path, messages: map[string]*lockedFields{}}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return lock, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &lock.messages); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %v", path, err)
+	}
+	return lock, nil
+}
+
+// write writes the lock file.
+func (l *fieldNumberLock) write() error {
+	data, err := yaml.Marshal(l.messages)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(l.path, data, 0644)
+}
+
+// message returns the locked fields of the message, or nil without lock.
+func (l *fieldNumberLock) message(name string) *lockedFields {
+	if l == nil {
+		return nil
+	}
+	locked, ok := l.messages[name]
+	if !ok {
+		locked = &lockedFields{}
+		l.messages[name] = locked
+	}
+	return locked
+}
+
+// number returns the number of the field, if it is or was a field of the
+// message.
+func (l *lockedFields) number(name string) (int, bool) {
+	if n, ok := l.Fields[name]; ok {
+		return n, true
+	}
+	n, ok := l.Removed[name]
+	return n, ok
+}
+
+// highest returns the highest number ever used by a field of the message.
+func (l *lockedFields) highest() int {
+	highest := 0
+	for _, numbers := range []map[string]int{l.Fields, l.Removed} {
+		for _, n := range numbers {
+			if n > highest {
+				highest = n
+			}
+		}
+	}
+	return highest
+}
+
+// update checks that the fields keep their numbers and do not reuse the
+// numbers of other fields, which would break wire compatibility, and records
+// them, the fields no longer present being recorded as removed.
+func (l *lockedFields) update(fields []protoField) error {
+	if l == nil {
+		return nil
+	}
+	owners := map[int]string{}
+	for _, numbers := range []map[string]int{l.Fields, l.Removed} {
+		for name, n := range numbers {
+			owners[n] = name
+		}
+	}
+	current := map[string]int{}
+	for _, field := range fields {
+		if n, ok := l.number(field.Name); ok && n != field.Tag {
+			return fmt.Errorf("field %q is locked to number %d, renumbering it to %d would break wire compatibility", field.Name, n, field.Tag)
+		}
+		if owner, ok := owners[field.Tag]; ok && owner != field.Name {
+			return fmt.Errorf("field %q reuses number %d of field %q, which would break wire compatibility", field.Name, field.Tag, owner)
+		}
+		current[field.Name] = field.Tag
+	}
+
+	for name, n := range l.Fields {
+		if _, ok := current[name]; !ok {
+			if l.Removed == nil {
+				l.Removed = map[string]int{}
+			}
+			l.Removed[name] = n
+		}
+	}
+	for name := range current {
+		delete(l.Removed, name)
+	}
+	if len(l.Removed) == 0 {
+		l.Removed = nil
+	}
+	l.Fields = current
+	return nil
+}
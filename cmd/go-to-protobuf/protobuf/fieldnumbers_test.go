@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLockedFieldsUpdate(t *testing.T) {
+	field := func(name string, tag int) protoField { return protoField{Name: name, Tag: tag} }
+
+	testcases := []struct {
+		Name      string
+		Locked    lockedFields
+		Fields    []protoField
+		Expect    lockedFields
+		ExpectErr bool
+	}{
+		{
+			Name:   "new message",
+			Fields: []protoField{field("name", 1), field("port", 2)},
+			Expect: lockedFields{Fields: map[string]int{"name": 1, "port": 2}},
+		},
+		{
+			Name:   "added and removed fields",
+			Locked: lockedFields{Fields: map[string]int{"name": 1, "type": 2}},
+			Fields: []protoField{field("name", 1), field("kind", 3)},
+			Expect: lockedFields{Fields: map[string]int{"name": 1, "kind": 3}, Removed: map[string]int{"type": 2}},
+		},
+		{
+			Name:   "restored field",
+			Locked: lockedFields{Fields: map[string]int{"name": 1}, Removed: map[string]int{"type": 2}},
+			Fields: []protoField{field("name", 1), field("type", 2)},
+			Expect: lockedFields{Fields: map[string]int{"name": 1, "type": 2}},
+		},
+		{
+			Name:      "renumbered field",
+			Locked:    lockedFields{Fields: map[string]int{"name": 1, "port": 2}},
+			Fields:    []protoField{field("name", 1), field("port", 3)},
+			ExpectErr: true,
+		},
+		{
+			Name:      "reused number of a removed field",
+			Locked:    lockedFields{Fields: map[string]int{"name": 1}, Removed: map[string]int{"type": 2}},
+			Fields:    []protoField{field("name", 1), field("kind", 2)},
+			ExpectErr: true,
+		},
+		{
+			Name:      "reused number of a renamed field",
+			Locked:    lockedFields{Fields: map[string]int{"name": 1, "type": 2}},
+			Fields:    []protoField{field("name", 1), field("kind", 2)},
+			ExpectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			locked := tc.Locked
+			if err := locked.update(tc.Fields); err != nil {
+				if !tc.ExpectErr {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.ExpectErr {
+				t.Fatalf("expected error, got none")
+			}
+			if !reflect.DeepEqual(locked, tc.Expect) {
+				t.Fatalf("expected %#v, got %#v", tc.Expect, locked)
+			}
+		})
+	}
+}
+
+func TestFieldNumberLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "field-numbers.yaml")
+
+	lock, err := loadFieldNumberLock(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading a missing lock file: %v", err)
+	}
+	if err := lock.message("example.com.v1.Widget").update([]protoField{{Name: "name", Tag: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.write(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := loadFieldNumberLock(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded.messages, lock.messages) {
+		t.Fatalf("expected %#v, got %#v", lock.messages, loaded.messages)
+	}
+
+	var disabled *fieldNumberLock
+	if locked := disabled.message("example.com.v1.Widget"); locked != nil {
+		t.Fatalf("expected no locked fields without lock, got %#v", locked)
+	}
+}
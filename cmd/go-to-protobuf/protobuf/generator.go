@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"path"
 	"reflect"
 	"sort"
 	"strconv"
@@ -44,12 +45,26 @@ type genProtoIDL struct {
 	generateAll    bool
 	omitGogo       bool
 	omitFieldTypes map[types.Name]struct{}
+
+	syntax         string
+	proto3Presence string
+	oneofs         bool
+	fieldNumbers   *fieldNumberLock
+	wellKnownTypes map[types.Name]*types.Type
+	// usedWellKnownTypes records the Go types mapped to well-known types used
+	// by the fields of the generated messages.
+	usedWellKnownTypes map[types.Name]bool
 }
 
 func (g *genProtoIDL) PackageVars(c *generator.Context) []string {
+	if g.syntax == syntaxEditions {
+		return append([]string{
+			fmt.Sprintf("option go_package = %q;", g.goPackage()),
+		}, editionsFileOptions...)
+	}
 	if g.omitGogo {
 		return []string{
-			fmt.Sprintf("option go_package = %q;", g.localGoPackage.Package),
+			fmt.Sprintf("option go_package = %q;", g.goPackage()),
 		}
 	}
 	return []string{
@@ -62,8 +77,18 @@ func (g *genProtoIDL) PackageVars(c *generator.Context) []string {
 		"option (gogoproto.goproto_unrecognized_all) = false;",
 		"option (gogoproto.goproto_enum_prefix_all) = false;",
 		"option (gogoproto.goproto_getters_all) = false;",
-		fmt.Sprintf("option go_package = %q;", g.localGoPackage.Package),
+		fmt.Sprintf("option go_package = %q;", g.goPackage()),
+	}
+}
+
+// goPackage returns the go_package option of the IDL, the path of the Go
+// package followed by its name if it differs from the last element of the
+// path, e.g. example.com/foo/v2;foo.
+func (g *genProtoIDL) goPackage() string {
+	if g.localGoPackage.Name != path.Base(g.localGoPackage.Package) {
+		return g.localGoPackage.Package + ";" + g.localGoPackage.Name
 	}
+	return g.localGoPackage.Package
 }
 
 func (g *genProtoIDL) Filename() string { return g.OutputFilename + ".proto" }
@@ -80,6 +105,9 @@ func (g *genProtoIDL) Namers(c *generator.Context) namer.NameSystems {
 
 // Filter ignores types that are identified as not exportable.
 func (g *genProtoIDL) Filter(c *generator.Context, t *types.Type) bool {
+	if t.Kind == types.Interface {
+		return isService(t)
+	}
 	tagVals := gengo.ExtractCommentTags("+", t.CommentLines)["protobuf"]
 	if tagVals != nil {
 		if tagVals[0] == "false" {
@@ -169,13 +197,20 @@ func (g *genProtoIDL) GenerateType(c *generator.Context, t *types.Type, w io.Wri
 			tracker:  g.imports,
 			universe: c.Universe,
 
-			localGoPackage: g.localGoPackage.Package,
+			localGoPackage:     g.localGoPackage.Package,
+			wellKnownTypes:     g.wellKnownTypes,
+			usedWellKnownTypes: g.usedWellKnownTypes,
 		},
 		localPackage: g.localPackage,
 
 		omitGogo:       g.omitGogo,
 		omitFieldTypes: g.omitFieldTypes,
 
+		syntax:         g.syntax,
+		proto3Presence: g.proto3Presence,
+		oneofs:         g.oneofs,
+		fieldNumbers:   g.fieldNumbers,
+
 		t: t,
 	}
 	switch t.Kind {
@@ -183,6 +218,8 @@ func (g *genProtoIDL) GenerateType(c *generator.Context, t *types.Type, w io.Wri
 		return b.doAlias(sw)
 	case types.Struct:
 		return b.doStruct(sw)
+	case types.Interface:
+		return b.doService(sw)
 	default:
 		return b.unknown()
 	}
@@ -206,6 +243,10 @@ type protobufLocator struct {
 	universe types.Universe
 
 	localGoPackage string
+	// Go types mapped to well-known protobuf types
+	wellKnownTypes map[types.Name]*types.Type
+	// Go types mapped to well-known protobuf types which were located, if not nil
+	usedWellKnownTypes map[types.Name]bool
 }
 
 // CastTypeName returns the cast type name of a Go type
@@ -231,6 +272,14 @@ func (p protobufLocator) ProtoTypeFor(t *types.Type) (*types.Type, error) {
 		p.tracker.AddType(t)
 		return t, nil
 	}
+	// it's mapped to a well-known type
+	if wellKnown, ok := p.wellKnownTypes[t.Name]; ok {
+		if p.usedWellKnownTypes != nil {
+			p.usedWellKnownTypes[t.Name] = true
+		}
+		p.tracker.AddType(wellKnown)
+		return wellKnown, nil
+	}
 	// it's a fundamental type
 	if t, ok := isFundamentalProtoType(t); ok {
 		p.tracker.AddType(t)
@@ -256,6 +305,11 @@ type bodyGen struct {
 	omitGogo       bool
 	omitFieldTypes map[types.Name]struct{}
 
+	syntax         string
+	proto3Presence string
+	oneofs         bool
+	fieldNumbers   *fieldNumberLock
+
 	t *types.Type
 }
 
@@ -359,12 +413,30 @@ func (b bodyGen) doStruct(sw *generator.SnippetWriter) error {
 
 	// If we don't explicitly embed anything, generate fields by traversing fields.
 	if fields == nil {
-		memberFields, err := membersToFields(b.locator, alias, b.localPackage, b.omitFieldTypes)
+		locked := b.fieldNumbers.message(b.localPackage.Package + "." + b.t.Name.Name)
+		memberFields, err := membersToFields(b.locator, alias, b.localPackage, b.omitFieldTypes, locked)
 		if err != nil {
 			return fmt.Errorf("type %v cannot be converted to protobuf: %v", b.t, err)
 		}
+		if err := locked.update(memberFields); err != nil {
+			return fmt.Errorf("type %v cannot be converted to protobuf: %v", b.t, err)
+		}
 		fields = memberFields
 	}
+	if b.syntax == syntaxProto3 {
+		for i := range fields {
+			field, err := toProto3Field(b.locator, fields[i], b.proto3Presence)
+			if err != nil {
+				return fmt.Errorf("type %v cannot be converted to protobuf: %v", b.t, err)
+			}
+			fields[i] = field
+		}
+	}
+	if b.syntax == syntaxEditions {
+		for i := range fields {
+			fields[i] = toEditionsField(fields[i])
+		}
+	}
 
 	out := sw.Out()
 	genComment(out, b.t.CommentLines, "")
@@ -379,43 +451,105 @@ func (b bodyGen) doStruct(sw *generator.SnippetWriter) error {
 		fmt.Fprintln(out)
 	}
 
+	// the members of a union are generated as a oneof, in place of the first
+	// one, unless the IDL is compiled by protoc-gen-gogo, whose generated code
+	// would not match the struct.
+	var members map[int]bool
+	if b.oneofs {
+		var err error
+		if members, err = unionMembers(b.t, fields); err != nil {
+			return err
+		}
+	}
+
 	for i, field := range fields {
-		genComment(out, field.CommentLines, "  ")
-		fmt.Fprintf(out, "  ")
-		switch {
-		case field.Map:
-		case field.Repeated:
-			fmt.Fprintf(out, "repeated ")
-		case field.Required:
-			fmt.Fprintf(out, "required ")
-		default:
-			fmt.Fprintf(out, "optional ")
+		if members[field.Tag] && !isFirstMember(fields[:i], members) {
+			// generated in the oneof.
+			continue
 		}
-		sw.Do(`$.Type|local$ $.Name$ = $.Tag$`, field)
-		if len(field.Extras) > 0 {
-			extras := []string{}
-			for k, v := range field.Extras {
-				if b.omitGogo && strings.HasPrefix(k, "(gogoproto.") {
-					continue
-				}
-				extras = append(extras, fmt.Sprintf("%s = %s", k, v))
+		if i != 0 {
+			fmt.Fprintf(out, "\n")
+		}
+		if !members[field.Tag] {
+			b.doField(sw, field, false)
+			continue
+		}
+		fmt.Fprintf(out, "  oneof %s {\n", oneofName(b.t))
+		first := true
+		for _, member := range fields[i:] {
+			if !members[member.Tag] {
+				continue
 			}
-			sort.Strings(extras)
-			if len(extras) > 0 {
-				fmt.Fprintf(out, " [")
-				fmt.Fprint(out, strings.Join(extras, ", "))
-				fmt.Fprintf(out, "]")
+			if !first {
+				fmt.Fprintf(out, "\n")
 			}
+			first = false
+			b.doField(sw, member, true)
 		}
-		fmt.Fprintf(out, ";\n")
-		if i != len(fields)-1 {
-			fmt.Fprintf(out, "\n")
-		}
+		fmt.Fprintf(out, "  }\n")
 	}
 	fmt.Fprintf(out, "}\n\n")
 	return nil
 }
 
+// isFirstMember returns whether none of the previous fields are members of
+// the union.
+func isFirstMember(previous []protoField, members map[int]bool) bool {
+	for _, field := range previous {
+		if members[field.Tag] {
+			return false
+		}
+	}
+	return true
+}
+
+// doField generates a field of a message, or of a oneof, whose fields have no
+// labels.
+func (b bodyGen) doField(sw *generator.SnippetWriter, field protoField, inOneof bool) {
+	out := sw.Out()
+	indent := "  "
+	if inOneof {
+		indent = "    "
+	}
+	genComment(out, field.CommentLines, indent)
+	fmt.Fprintf(out, "%s", indent)
+	switch {
+	case field.Map:
+	case field.Repeated:
+		fmt.Fprintf(out, "repeated ")
+	case inOneof:
+		// oneof fields have no labels.
+	case b.syntax == syntaxProto3:
+		// proto3 fields are optional, with explicit presence if labelled.
+		if field.Optional {
+			fmt.Fprintf(out, "optional ")
+		}
+	case b.syntax == syntaxEditions:
+		// editions fields have no labels, their presence being a feature.
+	case field.Required:
+		fmt.Fprintf(out, "required ")
+	default:
+		fmt.Fprintf(out, "optional ")
+	}
+	sw.Do(`$.Type|local$ $.Name$ = $.Tag$`, field)
+	if len(field.Extras) > 0 {
+		extras := []string{}
+		for k, v := range field.Extras {
+			if b.omitGogo && strings.HasPrefix(k, "(gogoproto.") {
+				continue
+			}
+			extras = append(extras, fmt.Sprintf("%s = %s", k, v))
+		}
+		sort.Strings(extras)
+		if len(extras) > 0 {
+			fmt.Fprintf(out, " [")
+			fmt.Fprint(out, strings.Join(extras, ", "))
+			fmt.Fprintf(out, "]")
+		}
+	}
+	fmt.Fprintf(out, ";\n")
+}
+
 type protoField struct {
 	LocalPackage types.Name
 
@@ -619,7 +753,7 @@ func protobufTagToField(tag string, field *protoField, m types.Member, t *types.
 	return nil
 }
 
-func membersToFields(locator ProtobufLocator, t *types.Type, localPackage types.Name, omitFieldTypes map[types.Name]struct{}) ([]protoField, error) {
+func membersToFields(locator ProtobufLocator, t *types.Type, localPackage types.Name, omitFieldTypes map[types.Name]struct{}, locked *lockedFields) ([]protoField, error) {
 	fields := []protoField{}
 
 	for _, m := range t.Members {
@@ -703,6 +837,28 @@ func membersToFields(locator ProtobufLocator, t *types.Type, localPackage types.
 			highest = tag
 		}
 	}
+	// fields without tags keep their locked numbers, and new fields never get
+	// the numbers of removed fields
+	if locked != nil {
+		for i := range fields {
+			field := &fields[i]
+			if field.Tag != -1 {
+				continue
+			}
+			tag, ok := locked.number(field.Name)
+			if !ok {
+				continue
+			}
+			if existing, ok := byTag[tag]; ok {
+				return nil, fmt.Errorf("field %q and %q both have tag %d", field.Name, existing.Name, tag)
+			}
+			field.Tag = tag
+			byTag[tag] = field
+		}
+		if tag := locked.highest(); tag > highest {
+			highest = tag
+		}
+	}
 	// starting from the highest observed tag, assign new field tags
 	for i := range fields {
 		field := &fields[i]
@@ -738,10 +894,14 @@ func formatProtoFile(source []byte) ([]byte, error) {
 	return source, nil
 }
 
-func assembleProtoFile(w io.Writer, f *generator.File) {
+func assembleProtoFile(w io.Writer, f *generator.File, syntax string) {
 	w.Write(f.Header)
 
-	fmt.Fprint(w, "syntax = \"proto2\";\n\n")
+	if syntax == syntaxEditions {
+		fmt.Fprintf(w, "edition = %q;\n\n", edition)
+	} else {
+		fmt.Fprintf(w, "syntax = %q;\n\n", syntax)
+	}
 
 	if len(f.PackageName) > 0 {
 		fmt.Fprintf(w, "package %s;\n\n", f.PackageName)
@@ -767,8 +927,16 @@ func assembleProtoFile(w io.Writer, f *generator.File) {
 }
 
 func NewProtoFile() *generator.DefaultFileType {
+	return NewProtoFileForSyntax(syntaxProto2)
+}
+
+// NewProtoFileForSyntax returns the file type of the IDL in the given syntax,
+// proto2, proto3 or editions.
+func NewProtoFileForSyntax(syntax string) *generator.DefaultFileType {
 	return &generator.DefaultFileType{
-		Format:   formatProtoFile,
-		Assemble: assembleProtoFile,
+		Format: formatProtoFile,
+		Assemble: func(w io.Writer, f *generator.File) {
+			assembleProtoFile(w, f, syntax)
+		},
 	}
 }
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestGoPackage(t *testing.T) {
+	testcases := []struct {
+		Package types.Name
+		Expect  string
+	}{
+		{Package: types.Name{Package: "k8s.io/api/core/v1", Name: "v1"}, Expect: "k8s.io/api/core/v1"},
+		{Package: types.Name{Package: "example.com/widgets/v2", Name: "widgets"}, Expect: "example.com/widgets/v2;widgets"},
+		{Package: types.Name{Package: "go.example.com/widgets-api", Name: "widgets"}, Expect: "go.example.com/widgets-api;widgets"},
+	}
+
+	for _, tc := range testcases {
+		g := &genProtoIDL{localGoPackage: tc.Package}
+		if got := g.goPackage(); got != tc.Expect {
+			t.Errorf("%v: expected %q, got %q", tc.Package, tc.Expect, got)
+		}
+	}
+}
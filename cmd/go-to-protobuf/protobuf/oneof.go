@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"fmt"
+
+	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/types"
+)
+
+// defaultOneofName is the name of the oneof of the members of a union, unless
+// overridden with +protobuf.oneof=<name> on the type.
+const defaultOneofName = "union"
+
+// isUnionMember returns whether the field is a member of the union of its
+// struct, i.e. tagged with +unionMember or +k8s:unionMember.
+func isUnionMember(field protoField) bool {
+	tags := gengo.ExtractCommentTags("+", field.CommentLines)
+	_, member := tags["unionMember"]
+	_, k8sMember := tags["k8s:unionMember"]
+	return member || k8sMember
+}
+
+// oneofName returns the name of the oneof of the members of the union of t.
+func oneofName(t *types.Type) string {
+	if v := gengo.ExtractCommentTags("+", t.CommentLines)["protobuf.oneof"]; len(v) > 0 && len(v[0]) > 0 {
+		return v[0]
+	}
+	return defaultOneofName
+}
+
+// unionMembers returns the members of the union of the fields, which are
+// generated as a oneof. They are encoded like the other fields, so the IDL
+// stays wire compatible with the IDL without oneof, but they cannot be
+// repeated or maps.
+func unionMembers(t *types.Type, fields []protoField) (map[int]bool, error) {
+	members := map[int]bool{}
+	for _, field := range fields {
+		if !isUnionMember(field) {
+			continue
+		}
+		if field.Repeated || field.Map {
+			return nil, fmt.Errorf("union member %q of %v cannot be repeated or a map in a oneof", field.Name, t.Name)
+		}
+		members[field.Tag] = true
+	}
+	if len(members) == 0 {
+		return members, nil
+	}
+	name := oneofName(t)
+	for _, field := range fields {
+		if field.Name == name {
+			return nil, fmt.Errorf("field %q of %v has the name of the oneof of its union, set another with +protobuf.oneof=<name>", field.Name, t.Name)
+		}
+	}
+	return members, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestUnionMembers(t *testing.T) {
+	union := &types.Type{Name: types.Name{Package: "example.com/v1", Name: "Gadget"}}
+	named := &types.Type{Name: types.Name{Package: "example.com/v1", Name: "Gadget"}, CommentLines: []string{"+protobuf.oneof=settings"}}
+	member := func(name string, tag int, comments ...string) protoField {
+		return protoField{Name: name, Tag: tag, CommentLines: comments}
+	}
+
+	testcases := []struct {
+		Name      string
+		Type      *types.Type
+		Fields    []protoField
+		Expect    map[int]bool
+		ExpectErr bool
+	}{
+		{
+			Name:   "no union",
+			Type:   union,
+			Fields: []protoField{member("name", 1, "Name is a name."), member("union", 2)},
+			Expect: map[int]bool{},
+		},
+		{
+			Name: "members",
+			Type: union,
+			Fields: []protoField{
+				member("type", 1, "+unionDiscriminator"),
+				member("tcp", 2, "TCP settings.", "+unionMember"),
+				member("port", 3),
+				member("udp", 4, "+k8s:unionMember"),
+			},
+			Expect: map[int]bool{2: true, 4: true},
+		},
+		{
+			Name:      "repeated member",
+			Type:      union,
+			Fields:    []protoField{{Name: "tcp", Tag: 1, Repeated: true, CommentLines: []string{"+unionMember"}}},
+			ExpectErr: true,
+		},
+		{
+			Name:      "field named after the oneof",
+			Type:      union,
+			Fields:    []protoField{member("union", 1), member("tcp", 2, "+unionMember")},
+			ExpectErr: true,
+		},
+		{
+			Name:   "named oneof",
+			Type:   named,
+			Fields: []protoField{member("union", 1), member("tcp", 2, "+unionMember")},
+			Expect: map[int]bool{2: true},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			members, err := unionMembers(tc.Type, tc.Fields)
+			if err != nil {
+				if !tc.ExpectErr {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.ExpectErr {
+				t.Fatalf("expected error, got none")
+			}
+			if !reflect.DeepEqual(members, tc.Expect) {
+				t.Fatalf("expected %v, got %v", tc.Expect, members)
+			}
+		})
+	}
+}
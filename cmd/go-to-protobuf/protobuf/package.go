@@ -17,6 +17,7 @@ limitations under the License.
 package protobuf
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
 	"log"
@@ -62,6 +63,33 @@ type protobufPackage struct {
 	// If true, omit any gogoprotobuf extensions not defined as types.
 	OmitGogo bool
 
+	// The name of the Go package, which may differ from the last element of
+	// its path, e.g. for major version suffixes or vanity import paths.
+	GoName string
+
+	// The doc comment of the Go package, emitted after the header of the IDL.
+	DocComments []string
+
+	// The syntax of the IDL, proto2, proto3 or editions, and for proto3, how the presence
+	// of nullable scalar fields is declared, optional or wrappers.
+	Syntax         string
+	Proto3Presence string
+
+	// If true, generate the members of unions as oneofs, which is only
+	// possible in the IDL not compiled by protoc-gen-gogo.
+	Oneofs bool
+
+	// The lock of the field numbers of the messages, if any.
+	FieldNumbers *fieldNumberLock
+
+	// The Go types mapped to well-known protobuf types, which is only
+	// possible in the IDL not compiled by protoc-gen-gogo.
+	WellKnownTypes map[types.Name]*types.Type
+
+	// The Go types mapped to well-known protobuf types used by the fields of
+	// the messages of the last generated IDL.
+	usedWellKnownTypes map[types.Name]bool
+
 	// A list of field types that will be excluded from the output struct
 	OmitFieldTypes map[types.Name]struct{}
 
@@ -80,7 +108,7 @@ type protobufPackage struct {
 }
 
 func (p *protobufPackage) Clean() error {
-	for _, s := range []string{p.ImportPath(), p.OutputPath()} {
+	for _, s := range []string{p.ImportPath(), p.OutputPath(), wellKnownConvertersFilename} {
 		if err := os.Remove(filepath.Join(p.Dir(), filepath.Base(s))); err != nil && !os.IsNotExist(err) {
 			return err
 		}
@@ -88,6 +116,19 @@ func (p *protobufPackage) Clean() error {
 	return nil
 }
 
+// Header returns the header of the IDL, followed by the doc comment of the Go
+// package, so that the consumers of the IDL get the same documentation.
+func (p *protobufPackage) Header(filename string) []byte {
+	header := p.SimpleTarget.Header(filename)
+	if len(p.DocComments) == 0 {
+		return header
+	}
+	buf := bytes.NewBuffer(append([]byte{}, header...))
+	genComment(buf, p.DocComments, "")
+	buf.WriteString("\n")
+	return buf.Bytes()
+}
+
 func (p *protobufPackage) ProtoTypeName() types.Name {
 	return types.Name{
 		Name:    p.Path(),       // the go path "foo/bar/baz"
@@ -173,6 +214,7 @@ func (p *protobufPackage) generatorsFunc(c *generator.Context) []generator.Gener
 	generators := []generator.Generator{}
 
 	p.Imports.AddNullable()
+	p.usedWellKnownTypes = map[types.Name]bool{}
 
 	generators = append(generators, &genProtoIDL{
 		GoGenerator: generator.GoGenerator{
@@ -184,11 +226,49 @@ func (p *protobufPackage) generatorsFunc(c *generator.Context) []generator.Gener
 		generateAll:    p.GenerateAll,
 		omitGogo:       p.OmitGogo,
 		omitFieldTypes: p.OmitFieldTypes,
+		syntax:         p.Syntax,
+		proto3Presence: p.Proto3Presence,
+		oneofs:         p.Oneofs,
+		fieldNumbers:   p.FieldNumbers,
+		wellKnownTypes: p.WellKnownTypes,
+
+		usedWellKnownTypes: p.usedWellKnownTypes,
 	})
 	return generators
 }
 
+// WellKnownConverters returns the Go target of the converters between the Go
+// types of the package mapped to well-known protobuf types and the types
+// generated for them, which is named after the Go package rather than the
+// protobuf one. It must be executed after the IDL, whose messages determine
+// the converters to generate.
+func (p *protobufPackage) WellKnownConverters(header []byte) generator.Target {
+	return &generator.SimpleTarget{
+		PkgName:       p.GoPackageName(),
+		PkgPath:       p.Path(),
+		PkgDir:        p.Dir(),
+		HeaderComment: header,
+		FilterFunc:    p.filterFunc,
+		GeneratorsFunc: func(c *generator.Context) []generator.Generator {
+			if len(p.usedWellKnownTypes) == 0 {
+				return nil
+			}
+			return []generator.Generator{&genWellKnownConverters{
+				GoGenerator: generator.GoGenerator{
+					OutputFilename: wellKnownConvertersFilename,
+				},
+				targetPackage: p.Path(),
+				imports:       generator.NewImportTrackerForPackage(p.Path()),
+				used:          p.usedWellKnownTypes,
+			}}
+		},
+	}
+}
+
 func (p *protobufPackage) GoPackageName() string {
+	if len(p.GoName) > 0 {
+		return p.GoName
+	}
 	return filepath.Base(p.Path())
 }
 
@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"testing"
+)
+
+func TestProtobufPackageHeader(t *testing.T) {
+	p := newProtobufPackage("example.com/widgets/v1", "", "example.com.widgets.v1", true, nil)
+	p.HeaderComment = []byte("// header\n\n")
+
+	if got, expect := string(p.Header("generated.proto")), "// header\n\n"; got != expect {
+		t.Errorf("expected %q without doc comment, got %q", expect, got)
+	}
+
+	p.DocComments = []string{"Package v1 holds the widgets.", "", "Widgets are gadgets.", ""}
+	expect := "// header\n\n// Package v1 holds the widgets.\n//\n// Widgets are gadgets.\n\n"
+	if got := string(p.Header("generated.proto")); got != expect {
+		t.Errorf("expected %q, got %q", expect, got)
+	}
+}
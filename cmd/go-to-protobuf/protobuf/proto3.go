@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"fmt"
+
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	syntaxProto2 = "proto2"
+	syntaxProto3 = "proto3"
+
+	// presenceOptional marks the nullable scalar fields as proto3 optional
+	// fields, which protoc-gen-gogo does not support.
+	presenceOptional = "optional"
+	// presenceWrappers turns the nullable scalar fields into the wrapper
+	// messages of google/protobuf/wrappers.proto, which gogoproto maps to
+	// pointers with its wktpointer extension.
+	presenceWrappers = "wrappers"
+)
+
+// scalarWrappers maps the scalar protobuf types to their wrapper messages.
+var scalarWrappers = map[string]string{
+	"double": "DoubleValue",
+	"float":  "FloatValue",
+	"int64":  "Int64Value",
+	"uint64": "UInt64Value",
+	"int32":  "Int32Value",
+	"uint32": "UInt32Value",
+	"bool":   "BoolValue",
+	"string": "StringValue",
+	"bytes":  "BytesValue",
+}
+
+// isScalarField returns whether the field holds a scalar, rather than a
+// message or a map.
+func isScalarField(field protoField) bool {
+	return !field.Map && field.Type.Key == nil && len(field.Type.Name.Package) == 0 && scalarWrappers[field.Type.Name.Name] != ""
+}
+
+// toProto3Field adapts a field generated for proto2 to proto3, whose scalar
+// fields have no presence and are never pointers, and whose repeated numeric
+// and bool fields are packed by default: they are declared unpacked, to be
+// encoded like in proto2 and editions. With the optional presence,
+// nullable scalar fields are labelled optional, and with the wrappers
+// presence, they are wrapped into messages. Wrappers are not supported for
+// fields cast to another Go type, e.g. pointers to string types.
+func toProto3Field(locator ProtobufLocator, field protoField, presence string) (protoField, error) {
+	if !isScalarField(field) {
+		return field, nil
+	}
+	if field.Repeated {
+		if name := field.Type.Name.Name; name != "string" && name != "bytes" {
+			field.Extras["packed"] = "false"
+		}
+		return field, nil
+	}
+	delete(field.Extras, "(gogoproto.nullable)")
+	if !field.Nullable {
+		return field, nil
+	}
+	if presence != presenceWrappers {
+		field.Optional = true
+		return field, nil
+	}
+	if cast, ok := field.Extras["(gogoproto.casttype)"]; ok {
+		return field, fmt.Errorf("nullable field %q of type %s cannot be wrapped, use --proto3-presence=%s with --only-idl", field.Name, cast, presenceOptional)
+	}
+	wrapper, err := locator.ProtoTypeFor(&types.Type{
+		Name: types.Name{
+			Name:    scalarWrappers[field.Type.Name.Name],
+			Package: "google.protobuf",
+			Path:    "google/protobuf/wrappers.proto",
+		},
+		Kind: types.Protobuf,
+	})
+	if err != nil {
+		return field, err
+	}
+	field.Type = wrapper
+	field.Extras["(gogoproto.wktpointer)"] = "true"
+	return field, nil
+}
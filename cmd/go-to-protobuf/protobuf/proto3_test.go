@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+// fakeLocator locates protobuf types as themselves.
+type fakeLocator struct{}
+
+func (fakeLocator) ProtoTypeFor(t *types.Type) (*types.Type, error) { return t, nil }
+func (fakeLocator) GoTypeForName(name types.Name) *types.Type       { return nil }
+func (fakeLocator) CastTypeName(name types.Name) string             { return name.String() }
+
+func TestToProto3Field(t *testing.T) {
+	scalar := func(name string) *types.Type {
+		return &types.Type{Name: types.Name{Name: name}, Kind: types.Protobuf}
+	}
+	message := &types.Type{Name: types.Name{Name: "ObjectMeta", Package: "k8s.io.apimachinery.pkg.apis.meta.v1"}, Kind: types.Protobuf}
+	wrapper := &types.Type{Name: types.Name{Name: "Int32Value", Package: "google.protobuf", Path: "google/protobuf/wrappers.proto"}, Kind: types.Protobuf}
+
+	testcases := []struct {
+		Name      string
+		Presence  string
+		Field     protoField
+		Expect    protoField
+		ExpectErr bool
+	}{
+		{
+			Name:     "scalar",
+			Presence: presenceWrappers,
+			Field:    protoField{Name: "count", Type: scalar("int64"), Extras: map[string]string{"(gogoproto.nullable)": "false"}},
+			Expect:   protoField{Name: "count", Type: scalar("int64"), Extras: map[string]string{}},
+		},
+		{
+			Name:     "message",
+			Presence: presenceWrappers,
+			Field:    protoField{Name: "metadata", Type: message, Extras: map[string]string{"(gogoproto.nullable)": "false"}},
+			Expect:   protoField{Name: "metadata", Type: message, Extras: map[string]string{"(gogoproto.nullable)": "false"}},
+		},
+		{
+			Name:     "repeated scalar",
+			Presence: presenceWrappers,
+			Field:    protoField{Name: "tags", Type: scalar("string"), Repeated: true, Nullable: true, Extras: map[string]string{}},
+			Expect:   protoField{Name: "tags", Type: scalar("string"), Repeated: true, Nullable: true, Extras: map[string]string{}},
+		},
+		{
+			Name:     "repeated numeric scalar",
+			Presence: presenceWrappers,
+			Field:    protoField{Name: "ports", Type: scalar("int32"), Repeated: true, Extras: map[string]string{}},
+			Expect:   protoField{Name: "ports", Type: scalar("int32"), Repeated: true, Extras: map[string]string{"packed": "false"}},
+		},
+		{
+			Name:     "repeated bool",
+			Presence: presenceOptional,
+			Field:    protoField{Name: "flags", Type: scalar("bool"), Repeated: true, Extras: map[string]string{}},
+			Expect:   protoField{Name: "flags", Type: scalar("bool"), Repeated: true, Extras: map[string]string{"packed": "false"}},
+		},
+		{
+			Name:     "nullable scalar wrapped",
+			Presence: presenceWrappers,
+			Field:    protoField{Name: "replicas", Type: scalar("int32"), Nullable: true, Extras: map[string]string{}},
+			Expect:   protoField{Name: "replicas", Type: wrapper, Nullable: true, Extras: map[string]string{"(gogoproto.wktpointer)": "true"}},
+		},
+		{
+			Name:     "nullable scalar optional",
+			Presence: presenceOptional,
+			Field:    protoField{Name: "replicas", Type: scalar("int32"), Nullable: true, Extras: map[string]string{}},
+			Expect:   protoField{Name: "replicas", Type: scalar("int32"), Nullable: true, Optional: true, Extras: map[string]string{}},
+		},
+		{
+			Name:     "nullable cast scalar optional",
+			Presence: presenceOptional,
+			Field:    protoField{Name: "phase", Type: scalar("string"), Nullable: true, Extras: map[string]string{"(gogoproto.casttype)": `"Phase"`}},
+			Expect:   protoField{Name: "phase", Type: scalar("string"), Nullable: true, Optional: true, Extras: map[string]string{"(gogoproto.casttype)": `"Phase"`}},
+		},
+		{
+			Name:      "nullable cast scalar wrapped",
+			Presence:  presenceWrappers,
+			Field:     protoField{Name: "phase", Type: scalar("string"), Nullable: true, Extras: map[string]string{"(gogoproto.casttype)": `"Phase"`}},
+			ExpectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			field, err := toProto3Field(fakeLocator{}, tc.Field, tc.Presence)
+			if err != nil {
+				if !tc.ExpectErr {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.ExpectErr {
+				t.Fatalf("expected error, got none")
+			}
+			if !reflect.DeepEqual(field, tc.Expect) {
+				t.Fatalf("expected %#v, got %#v", tc.Expect, field)
+			}
+		})
+	}
+}
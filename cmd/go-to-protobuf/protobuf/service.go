@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+// isService returns whether t is an interface tagged with
+// +protobuf.service=true, whose methods are turned into the rpcs of a gRPC
+// service.
+func isService(t *types.Type) bool {
+	return t.Kind == types.Interface && extractBoolTagOrDie("protobuf.service", t.CommentLines)
+}
+
+// hasServices returns whether any type of the package is a service.
+func hasServices(pkg *types.Package) bool {
+	for _, t := range pkg.Types {
+		if isService(t) {
+			return true
+		}
+	}
+	return false
+}
+
+type protoRPC struct {
+	Name     string
+	Request  *types.Type
+	Response *types.Type
+
+	CommentLines []string
+}
+
+// serviceRPCs returns the rpcs of the methods of a service, sorted by name.
+// The methods must be of the form
+//
+//	Method(context.Context, *Request) (*Response, error)
+//
+// where Request and Response are messages.
+func serviceRPCs(locator ProtobufLocator, t *types.Type) ([]protoRPC, error) {
+	names := make([]string, 0, len(t.Methods))
+	for name := range t.Methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rpcs := make([]protoRPC, 0, len(names))
+	for _, name := range names {
+		m := t.Methods[name]
+		request, response, ok := rpcMessages(m.Signature)
+		if !ok {
+			return nil, fmt.Errorf("method %s of service %v must be of the form %s(context.Context, *Request) (*Response, error) with Request and Response structs", name, t.Name, name)
+		}
+		rpc := protoRPC{Name: name, CommentLines: m.CommentLines}
+		var err error
+		if rpc.Request, err = locator.ProtoTypeFor(request); err != nil {
+			return nil, fmt.Errorf("method %s of service %v: request %v: %v", name, t.Name, request.Name, err)
+		}
+		if rpc.Response, err = locator.ProtoTypeFor(response); err != nil {
+			return nil, fmt.Errorf("method %s of service %v: response %v: %v", name, t.Name, response.Name, err)
+		}
+		rpcs = append(rpcs, rpc)
+	}
+	return rpcs, nil
+}
+
+// rpcMessages returns the request and response structs of a method signature
+// of the form func(context.Context, *Request) (*Response, error).
+func rpcMessages(s *types.Signature) (request, response *types.Type, ok bool) {
+	if s == nil || s.Variadic || len(s.Parameters) != 2 || len(s.Results) != 2 {
+		return nil, nil, false
+	}
+	if s.Parameters[0].Type.Name != (types.Name{Package: "context", Name: "Context"}) {
+		return nil, nil, false
+	}
+	if s.Results[1].Type.Name != (types.Name{Name: "error"}) {
+		return nil, nil, false
+	}
+	request, response = s.Parameters[1].Type, s.Results[0].Type
+	if request.Kind != types.Pointer || request.Elem.Kind != types.Struct ||
+		response.Kind != types.Pointer || response.Elem.Kind != types.Struct {
+		return nil, nil, false
+	}
+	return request.Elem, response.Elem, true
+}
+
+func (b bodyGen) doService(sw *generator.SnippetWriter) error {
+	rpcs, err := serviceRPCs(b.locator, b.t)
+	if err != nil {
+		return err
+	}
+
+	out := sw.Out()
+	genComment(out, b.t.CommentLines, "")
+	sw.Do("service $.Name.Name$ {\n", b.t)
+	for i, rpc := range rpcs {
+		genComment(out, rpc.CommentLines, "  ")
+		sw.Do("  rpc $.Name$($.Request|local$) returns ($.Response|local$);\n", rpc)
+		if i != len(rpcs)-1 {
+			fmt.Fprintf(out, "\n")
+		}
+	}
+	fmt.Fprintf(out, "}\n\n")
+	return nil
+}
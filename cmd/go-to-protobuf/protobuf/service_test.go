@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestServiceRPCs(t *testing.T) {
+	context := &types.Type{Name: types.Name{Package: "context", Name: "Context"}, Kind: types.Interface}
+	request := &types.Type{Name: types.Name{Package: "example.com/v1", Name: "GetRequest"}, Kind: types.Struct}
+	response := &types.Type{Name: types.Name{Package: "example.com/v1", Name: "Widget"}, Kind: types.Struct}
+	pointer := func(t *types.Type) *types.Type {
+		return &types.Type{Name: types.Name{Name: "*" + t.Name.String()}, Kind: types.Pointer, Elem: t}
+	}
+	errorType := &types.Type{Name: types.Name{Name: "error"}, Kind: types.Interface}
+	param := func(t *types.Type) *types.ParamResult { return &types.ParamResult{Type: t} }
+	method := func(params, results []*types.ParamResult) *types.Type {
+		return &types.Type{Kind: types.Func, Signature: &types.Signature{Parameters: params, Results: results}, CommentLines: []string{"Method comment."}}
+	}
+	service := func(methods map[string]*types.Type) *types.Type {
+		return &types.Type{Name: types.Name{Package: "example.com/v1", Name: "WidgetService"}, Kind: types.Interface, Methods: methods}
+	}
+
+	testcases := []struct {
+		Name      string
+		Methods   map[string]*types.Type
+		Expect    []protoRPC
+		ExpectErr bool
+	}{
+		{
+			Name: "unary methods sorted by name",
+			Methods: map[string]*types.Type{
+				"Update": method([]*types.ParamResult{param(context), param(pointer(response))}, []*types.ParamResult{param(pointer(response)), param(errorType)}),
+				"Get":    method([]*types.ParamResult{param(context), param(pointer(request))}, []*types.ParamResult{param(pointer(response)), param(errorType)}),
+			},
+			Expect: []protoRPC{
+				{Name: "Get", Request: request, Response: response, CommentLines: []string{"Method comment."}},
+				{Name: "Update", Request: response, Response: response, CommentLines: []string{"Method comment."}},
+			},
+		},
+		{
+			Name: "no context",
+			Methods: map[string]*types.Type{
+				"Get": method([]*types.ParamResult{param(pointer(request))}, []*types.ParamResult{param(pointer(response)), param(errorType)}),
+			},
+			ExpectErr: true,
+		},
+		{
+			Name: "no error",
+			Methods: map[string]*types.Type{
+				"Get": method([]*types.ParamResult{param(context), param(pointer(request))}, []*types.ParamResult{param(pointer(response))}),
+			},
+			ExpectErr: true,
+		},
+		{
+			Name: "request is not a pointer",
+			Methods: map[string]*types.Type{
+				"Get": method([]*types.ParamResult{param(context), param(request)}, []*types.ParamResult{param(pointer(response)), param(errorType)}),
+			},
+			ExpectErr: true,
+		},
+		{
+			Name: "response is not a struct",
+			Methods: map[string]*types.Type{
+				"Get": method([]*types.ParamResult{param(context), param(pointer(request))}, []*types.ParamResult{param(pointer(types.String)), param(errorType)}),
+			},
+			ExpectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			rpcs, err := serviceRPCs(fakeLocator{}, service(tc.Methods))
+			if err != nil {
+				if !tc.ExpectErr {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.ExpectErr {
+				t.Fatalf("expected error, got none")
+			}
+			if !reflect.DeepEqual(rpcs, tc.Expect) {
+				t.Fatalf("expected %#v, got %#v", tc.Expect, rpcs)
+			}
+		})
+	}
+}
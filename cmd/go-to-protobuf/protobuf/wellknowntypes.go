@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"io"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+)
+
+const (
+	metav1Package = "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wellKnownConvertersFilename = "generated.wellknowntypes.go"
+)
+
+var (
+	timestampType = &types.Type{
+		Name: types.Name{Name: "Timestamp", Package: "google.protobuf", Path: "google/protobuf/timestamp.proto"},
+		Kind: types.Protobuf,
+	}
+	durationType = &types.Type{
+		Name: types.Name{Name: "Duration", Package: "google.protobuf", Path: "google/protobuf/duration.proto"},
+		Kind: types.Protobuf,
+	}
+)
+
+// wellKnownTypes returns the Go types mapped to well-known protobuf types:
+// metav1.Time and metav1.MicroTime to google.protobuf.Timestamp, which they
+// are encoded like, and metav1.Duration to google.protobuf.Duration, which it
+// is not, being encoded as nanoseconds.
+func wellKnownTypes(time, duration bool) map[types.Name]*types.Type {
+	wellKnown := map[types.Name]*types.Type{}
+	if time {
+		wellKnown[types.Name{Package: metav1Package, Name: "Time"}] = timestampType
+		wellKnown[types.Name{Package: metav1Package, Name: "MicroTime"}] = timestampType
+	}
+	if duration {
+		wellKnown[types.Name{Package: metav1Package, Name: "Duration"}] = durationType
+	}
+	return wellKnown
+}
+
+// genWellKnownConverters produces the converters between the Go types mapped
+// to well-known types and the Go types of the well-known types, which encode
+// and decode them like their protobuf encoding. They are unexported, not to
+// add to the API of the package, and only generated for the Go types used by
+// the fields of the messages of the IDL.
+type genWellKnownConverters struct {
+	generator.GoGenerator
+	targetPackage string
+	imports       namer.ImportTracker
+	// used are the Go types mapped to well-known types used by the fields of
+	// the messages of the IDL.
+	used map[types.Name]bool
+}
+
+func (g *genWellKnownConverters) Namers(c *generator.Context) namer.NameSystems {
+	return namer.NameSystems{
+		"raw": namer.NewRawNamer(g.targetPackage, g.imports),
+	}
+}
+
+func (g *genWellKnownConverters) Imports(c *generator.Context) []string {
+	return g.imports.ImportLines()
+}
+
+func (g *genWellKnownConverters) Init(c *generator.Context, w io.Writer) error {
+	sw := generator.NewSnippetWriter(w, c, "$", "$")
+	args := generator.Args{
+		"Time":          types.Ref(metav1Package, "Time"),
+		"MicroTime":     types.Ref(metav1Package, "MicroTime"),
+		"Duration":      types.Ref(metav1Package, "Duration"),
+		"Timestamp":     types.Ref("google.golang.org/protobuf/types/known/timestamppb", "Timestamp"),
+		"ProtoDuration": types.Ref("google.golang.org/protobuf/types/known/durationpb", "Duration"),
+		"newDuration":   types.Ref("google.golang.org/protobuf/types/known/durationpb", "New"),
+		"unix":          types.Ref("time", "Unix"),
+		"errorf":        types.Ref("fmt", "Errorf"),
+	}
+	if g.used[types.Name{Package: metav1Package, Name: "Time"}] {
+		sw.Do(timeConverters, args.With("Type", args["Time"]).With("Name", "Time").With("name", "time"))
+	}
+	if g.used[types.Name{Package: metav1Package, Name: "MicroTime"}] {
+		sw.Do(timeConverters, args.With("Type", args["MicroTime"]).With("Name", "MicroTime").With("name", "microTime"))
+	}
+	if g.used[types.Name{Package: metav1Package, Name: "Duration"}] {
+		sw.Do(durationConverters, args)
+	}
+	return sw.Error()
+}
+
+var timeConverters = `
+// $.name$ToTimestamp converts a $.Type|raw$ to a Timestamp like its protobuf
+// encoding, the zero time being omitted, i.e. nil.
+func $.name$ToTimestamp(in $.Type|raw$) *$.Timestamp|raw$ {
+	if in.IsZero() {
+		return nil
+	}
+	return &$.Timestamp|raw${Seconds: in.Unix(), Nanos: int32(in.Nanosecond())}
+}
+
+// timestampTo$.Name$ converts a Timestamp to a $.Type|raw$ like its protobuf
+// decoding, a nil or zero Timestamp being the zero time.
+func timestampTo$.Name$(in *$.Timestamp|raw$) $.Type|raw$ {
+	if in == nil || (in.Seconds == 0 && in.Nanos == 0) {
+		return $.Type|raw${}
+	}
+	return $.Type|raw${Time: $.unix|raw$(in.Seconds, int64(in.Nanos)).Local()}
+}
+`
+
+var durationConverters = `
+// durationToProtoDuration converts a $.Duration|raw$ to a protobuf Duration.
+func durationToProtoDuration(in $.Duration|raw$) *$.ProtoDuration|raw$ {
+	return $.newDuration|raw$(in.Duration)
+}
+
+// protoDurationToDuration converts a protobuf Duration to a $.Duration|raw$, a
+// nil Duration being zero. It fails for the Durations out of the range of
+// $.Duration|raw$, rather than saturating them.
+func protoDurationToDuration(in *$.ProtoDuration|raw$) ($.Duration|raw$, error) {
+	if in == nil {
+		return $.Duration|raw${}, nil
+	}
+	if err := in.CheckValid(); err != nil {
+		return $.Duration|raw${}, err
+	}
+	d := in.AsDuration()
+	if out := $.newDuration|raw$(d); out.Seconds != in.Seconds || out.Nanos != in.Nanos {
+		return $.Duration|raw${}, $.errorf|raw$("duration %v is out of range", in)
+	}
+	return $.Duration|raw${Duration: d}, nil
+}
+`
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestProtoTypeForWellKnownTypes(t *testing.T) {
+	timeName := types.Name{Package: metav1Package, Name: "Time"}
+	microTimeName := types.Name{Package: metav1Package, Name: "MicroTime"}
+	durationName := types.Name{Package: metav1Package, Name: "Duration"}
+	metaTime := &types.Type{Name: timeName, Kind: types.Struct}
+	metaDuration := &types.Type{Name: durationName, Kind: types.Struct}
+
+	testcases := []struct {
+		Name     string
+		Time     bool
+		Duration bool
+		Expect   map[types.Name]bool
+	}{
+		{
+			Name:   "none",
+			Expect: map[types.Name]bool{},
+		},
+		{
+			// MicroTime is mapped, but not located.
+			Name:   "time",
+			Time:   true,
+			Expect: map[types.Name]bool{timeName: true},
+		},
+		{
+			Name:     "duration",
+			Duration: true,
+			Expect:   map[types.Name]bool{durationName: true},
+		},
+		{
+			Name:     "all",
+			Time:     true,
+			Duration: true,
+			Expect:   map[types.Name]bool{timeName: true, durationName: true},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			wellKnown := wellKnownTypes(tc.Time, tc.Duration)
+			if _, ok := wellKnown[microTimeName]; ok != tc.Time {
+				t.Fatalf("expected MicroTime to be mapped: %v", tc.Time)
+			}
+			locator := protobufLocator{
+				namer:              NewProtobufNamer(),
+				tracker:            NewImportTracker(types.Name{Package: "example.v1"}),
+				wellKnownTypes:     wellKnown,
+				usedWellKnownTypes: map[types.Name]bool{},
+			}
+			for _, ft := range []*types.Type{types.String, metaTime, metaDuration} {
+				protoType, err := locator.ProtoTypeFor(ft)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if wellKnown, ok := wellKnown[ft.Name]; ok && protoType != wellKnown {
+					t.Errorf("expected %v to be located as %v, got %v", ft.Name, wellKnown.Name, protoType.Name)
+				}
+			}
+			if !reflect.DeepEqual(locator.usedWellKnownTypes, tc.Expect) {
+				t.Errorf("expected %v, got %v", tc.Expect, locator.usedWellKnownTypes)
+			}
+		})
+	}
+}
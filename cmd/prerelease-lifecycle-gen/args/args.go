@@ -18,13 +18,37 @@ package args
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/spf13/pflag"
 )
 
+var releaseRegex = regexp.MustCompile(`^\d+\.\d+$`)
+
 type Args struct {
 	OutputFile   string
 	GoHeaderFile string
+
+	// ManifestFile is the path of the manifest of the lifecycle of the
+	// generated types, written as YAML if it ends with .yaml or .yml, else as
+	// JSON. No manifest is written if it is empty.
+	ManifestFile string
+
+	// Verify makes the generator verify the lifecycle tags of the types, and
+	// fail if they are invalid or violate the deprecation policy, instead of
+	// generating.
+	Verify bool
+
+	// CRDDeprecationsFile is the path of the YAML file holding the
+	// deprecated and deprecationWarning fields of the versions of the
+	// CustomResourceDefinitions of the generated types, as of Release. No
+	// file is written if it is empty.
+	CRDDeprecationsFile string
+	Release             string
+
+	// EmulationAwareRemoval makes the generator generate APILifecycleRemovedIn
+	// methods, returning whether the types are removed in a given version.
+	EmulationAwareRemoval bool
 }
 
 // New returns default arguments for the generator.
@@ -38,6 +62,16 @@ func (args *Args) AddFlags(fs *pflag.FlagSet) {
 		"the name of the file to be generated")
 	fs.StringVar(&args.GoHeaderFile, "go-header-file", "",
 		"the path to a file containing boilerplate header text; the string \"YEAR\" will be replaced with the current 4-digit year")
+	fs.StringVar(&args.ManifestFile, "manifest-file", "",
+		"the path of a manifest of the lifecycle of all the generated types, written as YAML if it ends with .yaml or .yml, else as JSON")
+	fs.BoolVar(&args.Verify, "verify", false,
+		"verify the lifecycle tags of the types instead of generating, failing if a served type has no introduced tag, if its releases are out of order, or if a prerelease API outlives the deprecation policy")
+	fs.StringVar(&args.CRDDeprecationsFile, "crd-deprecations-file", "",
+		"the path of a YAML file holding the deprecated and deprecationWarning fields of the CustomResourceDefinition versions of the generated types, to be merged into their manifests; requires --release")
+	fs.StringVar(&args.Release, "release", "",
+		"the <major>.<minor> release, e.g. 1.30, as of which the versions are deprecated in --crd-deprecations-file")
+	fs.BoolVar(&args.EmulationAwareRemoval, "emulation-aware-removal", false,
+		"generate APILifecycleRemovedIn methods, returning whether the types are removed in a given version, e.g. the emulated version of a component")
 }
 
 // Validate checks the given arguments.
@@ -45,6 +79,9 @@ func (args *Args) Validate() error {
 	if len(args.OutputFile) == 0 {
 		return fmt.Errorf("--output-file must be specified")
 	}
+	if len(args.CRDDeprecationsFile) > 0 && !releaseRegex.MatchString(args.Release) {
+		return fmt.Errorf("--crd-deprecations-file requires --release=<major>.<minor>, got %q", args.Release)
+	}
 
 	return nil
 }
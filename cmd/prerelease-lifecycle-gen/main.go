@@ -30,12 +30,67 @@ limitations under the License.
 // // +k8s:prerelease-lifecycle-gen:removed=1.25
 // // +k8s:prerelease-lifecycle-gen:replacement=wardle.example.com,v1,Flunder
 //
+// The introduced, deprecated and removed tags may also be set in doc.go, next
+// to the package-scoped tag, for all the types of the package which do not set
+// them.
+//
+// Prerelease APIs are deprecated three minor releases after being introduced,
+// and removed three minor releases later, unless their tags say otherwise.
+// Packages with different support windows can set these offsets in doc.go:
+//
+//	// +k8s:prerelease-lifecycle-gen:deprecation-offset=2
+//	// +k8s:prerelease-lifecycle-gen:removal-offset=6
+//
+// The replacement tag may be repeated for types whose successor depends on
+// their use, in order of preference. APILifecycleReplacement then returns the
+// preferred replacement, and APILifecycleReplacements all of them.
+//
+// With --manifest-file, the lifecycle of all the generated types, i.e. their
+// introduced, deprecated and removed releases, their replacements and their
+// deprecation warning, is written to a JSON manifest, or YAML if the file ends
+// with .yaml or .yml, for documentation and deprecation dashboards.
+//
+// With --crd-deprecations-file and --release, the versions of the types which
+// are deprecated as of the release are written to a YAML file, grouped by the
+// group, from the "+groupName" package tag, and kind of their
+// CustomResourceDefinition, with their deprecated and deprecationWarning
+// fields, ready to be merged into the versions of its manifest. The versions
+// without deprecation warning tag get the warning of the API server for
+// built-in types.
+//
+// With --emulation-aware-removal, an APILifecycleRemovedIn method is generated
+// alongside APILifecycleRemoved, returning whether the type is removed in a
+// given version, of any type with Major and Minor methods, such as
+// k8s.io/apimachinery/pkg/util/version.Version. Components emulating an
+// older release can then gate the removal on their emulated version rather
+// than on the version of their binary.
+//
+// With --verify, nothing is generated. Instead, generation fails if a served
+// type has no introduced tag, if its releases are out of order, e.g. deprecated
+// before being introduced, or if a prerelease API is deprecated or removed
+// later than the deprecation policy allows, i.e. three releases after being
+// introduced and deprecated, or the offsets of its package.
+//
 // Note that registration is a whole-package option, and is not available for
 // individual types.
+//
+// A custom deprecation warning can be set for a type, or for all the types of
+// a package in doc.go:
+//
+//	// +k8s:prerelease-lifecycle-gen:deprecation-warning={version} {kind} is deprecated in {deprecated}; use {replacement}
+//
+// which generates an APILifecycleDeprecationWarning method. The {kind},
+// {version}, {introduced}, {deprecated}, {removed} and {replacement}
+// placeholders are replaced with the type name, the version of its package, its
+// lifecycle releases and its replacement. The apiserver does not look the method
+// up, it still sends its default warning: the method is only used by the
+// callers checking for it themselves.
 package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
 
 	"github.com/spf13/pflag"
 	"k8s.io/code-generator/cmd/prerelease-lifecycle-gen/args"
@@ -59,6 +114,26 @@ func main() {
 	}
 
 	myTargets := func(context *generator.Context) []generator.Target {
+		if args.Verify {
+			findings := statusgenerators.Verify(context)
+			for _, finding := range findings {
+				fmt.Fprintln(os.Stderr, finding)
+			}
+			if len(findings) > 0 {
+				klog.Fatalf("Error: found %d lifecycle errors", len(findings))
+			}
+			return nil
+		}
+		if len(args.ManifestFile) > 0 {
+			if err := statusgenerators.WriteManifest(context, args); err != nil {
+				klog.Fatalf("Error writing manifest: %v", err)
+			}
+		}
+		if len(args.CRDDeprecationsFile) > 0 {
+			if err := statusgenerators.WriteCRDDeprecations(context, args); err != nil {
+				klog.Fatalf("Error writing CRD deprecations: %v", err)
+			}
+		}
 		return statusgenerators.GetTargets(context, args)
 	}
 
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prereleaselifecyclegenerators
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"k8s.io/code-generator/cmd/prerelease-lifecycle-gen/args"
+	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"sigs.k8s.io/yaml"
+)
+
+// CRDDeprecation holds the deprecated versions of the CustomResourceDefinition
+// of a kind, to be merged into the versions of its manifest by name.
+type CRDDeprecation struct {
+	Group    string                  `json:"group"`
+	Kind     string                  `json:"kind"`
+	Versions []CRDVersionDeprecation `json:"versions"`
+}
+
+// CRDVersionDeprecation holds the deprecation fields of a version of a
+// CustomResourceDefinition.
+type CRDVersionDeprecation struct {
+	Name               string `json:"name"`
+	Deprecated         bool   `json:"deprecated"`
+	DeprecationWarning string `json:"deprecationWarning,omitempty"`
+}
+
+// WriteCRDDeprecations writes the deprecated versions, as of args.Release, of
+// the API types of the input packages requesting generation to
+// args.CRDDeprecationsFile.
+func WriteCRDDeprecations(context *generator.Context, args *args.Args) error {
+	var major, minor int
+	if _, err := fmt.Sscanf(args.Release, "%d.%d", &major, &minor); err != nil {
+		return fmt.Errorf("invalid release %q: %w", args.Release, err)
+	}
+	deprecations, err := crdDeprecations(context, major, minor)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(deprecations)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(args.CRDDeprecationsFile, data, 0644)
+}
+
+// crdDeprecations returns the versions of the API types of the input packages
+// requesting generation which are deprecated as of the release major.minor,
+// grouped by group and kind.
+func crdDeprecations(context *generator.Context, major, minor int) ([]CRDDeprecation, error) {
+	entries, err := manifestEntries(context)
+	if err != nil {
+		return nil, err
+	}
+	byGroupKind := map[string]*CRDDeprecation{}
+	for _, entry := range entries {
+		if len(entry.Deprecated) == 0 {
+			continue
+		}
+		// The List types are served along with their kinds, they have no
+		// CustomResourceDefinition of their own.
+		if isListType(context.Universe[entry.Package].Types[entry.Kind]) {
+			continue
+		}
+		var deprecatedMajor, deprecatedMinor int
+		if _, err := fmt.Sscanf(entry.Deprecated, "%d.%d", &deprecatedMajor, &deprecatedMinor); err != nil {
+			return nil, err
+		}
+		if compareReleases(major, minor, deprecatedMajor, deprecatedMinor) < 0 {
+			continue
+		}
+		group := crdGroup(context.Universe[entry.Package].Comments, entry.Package)
+		key := group + "/" + entry.Kind
+		deprecation, found := byGroupKind[key]
+		if !found {
+			deprecation = &CRDDeprecation{Group: group, Kind: entry.Kind}
+			byGroupKind[key] = deprecation
+		}
+		warning := entry.DeprecationWarning
+		if len(warning) == 0 {
+			warning = defaultDeprecationWarning(group, entry)
+		}
+		deprecation.Versions = append(deprecation.Versions, CRDVersionDeprecation{
+			Name:               entry.Version,
+			Deprecated:         true,
+			DeprecationWarning: warning,
+		})
+	}
+
+	deprecations := make([]CRDDeprecation, 0, len(byGroupKind))
+	for _, deprecation := range byGroupKind {
+		sort.Slice(deprecation.Versions, func(i, j int) bool {
+			return deprecation.Versions[i].Name < deprecation.Versions[j].Name
+		})
+		deprecations = append(deprecations, *deprecation)
+	}
+	sort.Slice(deprecations, func(i, j int) bool {
+		if deprecations[i].Group != deprecations[j].Group {
+			return deprecations[i].Group < deprecations[j].Group
+		}
+		return deprecations[i].Kind < deprecations[j].Kind
+	})
+	return deprecations, nil
+}
+
+// crdGroup returns the group of a package, from its +groupName tag, or else
+// from the parent of its version directory, as register-gen does.
+func crdGroup(packageComments []string, pkgPath string) string {
+	if groupNames := gengo.ExtractCommentTags("+", packageComments)["groupName"]; len(groupNames) > 0 {
+		return groupNames[0]
+	}
+	return path.Base(path.Dir(pkgPath))
+}
+
+// isListType returns whether t is the List type of a kind, i.e. is named
+// <Kind>List and embeds ListMeta.
+func isListType(t *types.Type) bool {
+	if t == nil || !strings.HasSuffix(t.Name.Name, "List") {
+		return false
+	}
+	for _, member := range t.Members {
+		if member.Embedded && member.Name == "ListMeta" {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultDeprecationWarning returns the warning the API server sends for a
+// deprecated built-in type, for the CustomResourceDefinition versions with no
+// deprecation warning tag.
+func defaultDeprecationWarning(group string, entry ManifestEntry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s is deprecated in v%s+", schemaGroupVersion(group, entry.Version), entry.Kind, entry.Deprecated)
+	if len(entry.Removed) > 0 {
+		fmt.Fprintf(&b, ", unavailable in v%s+", entry.Removed)
+	}
+	if len(entry.Replacements) > 0 {
+		r := entry.Replacements[0]
+		fmt.Fprintf(&b, "; use %s %s", schemaGroupVersion(r.Group, r.Version), r.Kind)
+	}
+	return b.String()
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prereleaselifecyclegenerators
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+func Test_crdDeprecations(t *testing.T) {
+	apiType := func(pkg, name string, comments ...string) *types.Type {
+		return &types.Type{
+			Name:         types.Name{Package: pkg, Name: name},
+			Kind:         types.Struct,
+			Members:      []types.Member{{Name: "TypeMeta", Embedded: true}},
+			CommentLines: comments,
+		}
+	}
+	listType := func(t *types.Type) *types.Type {
+		t.Members = append(t.Members, types.Member{Name: "ListMeta", Embedded: true})
+		return t
+	}
+	const alpha = "example.com/apis/widgets/v1alpha1"
+	const beta = "example.com/apis/widgets/v1beta1"
+	const ga = "example.com/apis/widgets/v1"
+	universe := types.Universe{
+		alpha: &types.Package{
+			Path:     alpha,
+			Comments: []string{"+k8s:prerelease-lifecycle-gen=true", "+groupName=widgets.example.com"},
+			Types: map[string]*types.Type{
+				"Widget": apiType(alpha, "Widget",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:replacement=widgets.example.com,v1,Widget",
+				),
+				// The List type of Widget has no CustomResourceDefinition.
+				"WidgetList": listType(apiType(alpha, "WidgetList",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+				)),
+				"Gadget": apiType(alpha, "Gadget",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:deprecation-warning={kind} is going away",
+				),
+			},
+		},
+		beta: &types.Package{
+			Path:     beta,
+			Comments: []string{"+k8s:prerelease-lifecycle-gen=true", "+groupName=widgets.example.com"},
+			Types: map[string]*types.Type{
+				"Widget": apiType(beta, "Widget",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.22",
+				),
+			},
+		},
+		ga: &types.Package{
+			Path:     ga,
+			Comments: []string{"+k8s:prerelease-lifecycle-gen=true"},
+			Types: map[string]*types.Type{
+				"Widget": apiType(ga, "Widget",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.23",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.23",
+				),
+			},
+		},
+	}
+	context := &generator.Context{
+		Universe: universe,
+		Inputs:   []string{alpha, beta, ga},
+	}
+
+	deprecations, err := crdDeprecations(context, 1, 23)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []CRDDeprecation{
+		{
+			Group: "widgets",
+			Kind:  "Widget",
+			Versions: []CRDVersionDeprecation{{
+				Name:               "v1",
+				Deprecated:         true,
+				DeprecationWarning: "widgets/v1 Widget is deprecated in v1.23+",
+			}},
+		},
+		{
+			Group: "widgets.example.com",
+			Kind:  "Gadget",
+			Versions: []CRDVersionDeprecation{{
+				Name:               "v1alpha1",
+				Deprecated:         true,
+				DeprecationWarning: "Gadget is going away",
+			}},
+		},
+		{
+			Group: "widgets.example.com",
+			Kind:  "Widget",
+			Versions: []CRDVersionDeprecation{{
+				Name:               "v1alpha1",
+				Deprecated:         true,
+				DeprecationWarning: "widgets.example.com/v1alpha1 Widget is deprecated in v1.23+, unavailable in v1.26+; use widgets.example.com/v1 Widget",
+			}},
+		},
+	}
+	if diff := cmp.Diff(expected, deprecations); diff != "" {
+		t.Error(diff)
+	}
+}
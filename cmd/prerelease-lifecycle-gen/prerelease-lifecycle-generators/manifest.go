@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prereleaselifecyclegenerators
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+
+	"k8s.io/code-generator/cmd/prerelease-lifecycle-gen/args"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+	"sigs.k8s.io/yaml"
+)
+
+// ManifestEntry is the lifecycle of a type, as generated into its
+// APILifecycle methods, for the consumers of a manifest rather than Go code,
+// e.g. documentation and deprecation dashboards.
+type ManifestEntry struct {
+	Package string `json:"package"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+
+	// Introduced, Deprecated and Removed are releases formatted as
+	// <major>.<minor>. Deprecated and Removed are empty for the GA types with
+	// no deprecation or removal planned.
+	Introduced string `json:"introduced"`
+	Deprecated string `json:"deprecated,omitempty"`
+	Removed    string `json:"removed,omitempty"`
+
+	// Replacements are in order of preference.
+	Replacements       []ManifestReplacement `json:"replacements,omitempty"`
+	DeprecationWarning string                `json:"deprecationWarning,omitempty"`
+}
+
+// ManifestReplacement is a group, version and kind to be used instead of a
+// deprecated type.
+type ManifestReplacement struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+// WriteManifest writes the manifest of the lifecycle of the types of the input
+// packages requesting generation to args.ManifestFile.
+func WriteManifest(context *generator.Context, args *args.Args) error {
+	entries, err := manifestEntries(context)
+	if err != nil {
+		return err
+	}
+	var data []byte
+	if ext := path.Ext(args.ManifestFile); ext == ".yaml" || ext == ".yml" {
+		data, err = yaml.Marshal(entries)
+	} else {
+		data, err = json.MarshalIndent(entries, "", "  ")
+		data = append(data, '\n')
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(args.ManifestFile, data, 0644)
+}
+
+// manifestEntries returns the lifecycle of the API types of the input packages
+// requesting generation, sorted by package and kind.
+func manifestEntries(context *generator.Context) ([]ManifestEntry, error) {
+	entries := []ManifestEntry{}
+	for _, i := range context.Inputs {
+		pkg := context.Universe[i]
+		if !isPackageEnabled(pkg) {
+			continue
+		}
+		g := &genPreleaseLifecycle{
+			targetPackage:   pkg.Path,
+			packageComments: pkg.Comments,
+			imports:         generator.NewImportTracker(),
+		}
+		for _, t := range pkg.Types {
+			if !isAPIType(t) {
+				continue
+			}
+			a, err := g.argsFromType(context, t)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, manifestEntry(t, a))
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Package != entries[j].Package {
+			return entries[i].Package < entries[j].Package
+		}
+		return entries[i].Kind < entries[j].Kind
+	})
+	return entries, nil
+}
+
+// manifestEntry returns the manifest entry of t, from the arguments of its
+// generated methods.
+func manifestEntry(t *types.Type, a generator.Args) ManifestEntry {
+	release := func(name string) string {
+		major, found := a[name+"Major"]
+		if !found {
+			return ""
+		}
+		return fmt.Sprintf("%d.%d", major, a[name+"Minor"])
+	}
+	entry := ManifestEntry{
+		Package:    t.Name.Package,
+		Version:    path.Base(t.Name.Package),
+		Kind:       t.Name.Name,
+		Introduced: release("introduced"),
+		Deprecated: release("deprecated"),
+		Removed:    release("removed"),
+	}
+	if replacements, found := a["replacements"]; found {
+		for _, r := range replacements.([]replacement) {
+			entry.Replacements = append(entry.Replacements, ManifestReplacement(r))
+		}
+	} else if kind, found := a["replacementKind"]; found {
+		entry.Replacements = []ManifestReplacement{{
+			Group:   a["replacementGroup"].(string),
+			Version: a["replacementVersion"].(string),
+			Kind:    kind.(string),
+		}}
+	}
+	if warning, found := a["deprecationWarning"]; found {
+		entry.DeprecationWarning = warning.(string)
+	}
+	return entry
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prereleaselifecyclegenerators
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+func Test_manifestEntries(t *testing.T) {
+	typeMeta := types.Member{Name: "TypeMeta", Embedded: true}
+	apiType := func(pkg, name string, comments ...string) *types.Type {
+		return &types.Type{
+			Name:         types.Name{Package: pkg, Name: name},
+			Kind:         types.Struct,
+			Members:      []types.Member{typeMeta},
+			CommentLines: comments,
+		}
+	}
+	universe := types.Universe{
+		"k8s.io/apis/apps/v1beta1": &types.Package{
+			Path:     "k8s.io/apis/apps/v1beta1",
+			Comments: []string{"+k8s:prerelease-lifecycle-gen=true"},
+			Types: map[string]*types.Type{
+				"Widget": apiType("k8s.io/apis/apps/v1beta1", "Widget",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:replacement=apps,v1,Widget",
+					"+k8s:prerelease-lifecycle-gen:replacement=,v1,ConfigMap",
+					"+k8s:prerelease-lifecycle-gen:deprecation-warning=use {replacement}",
+				),
+				"Gadget": apiType("k8s.io/apis/apps/v1beta1", "Gadget",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.21",
+					"+k8s:prerelease-lifecycle-gen:replacement=apps,v1,Gadget",
+				),
+				"gadgetSpec": {Name: types.Name{Package: "k8s.io/apis/apps/v1beta1", Name: "gadgetSpec"}, Kind: types.Struct},
+			},
+		},
+		"k8s.io/apis/apps/v1": &types.Package{
+			Path:     "k8s.io/apis/apps/v1",
+			Comments: []string{"+k8s:prerelease-lifecycle-gen=true"},
+			Types: map[string]*types.Type{
+				"Widget": apiType("k8s.io/apis/apps/v1", "Widget",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.23",
+				),
+			},
+		},
+		"k8s.io/apis/apps/v2": &types.Package{
+			Path: "k8s.io/apis/apps/v2",
+			Types: map[string]*types.Type{
+				"Widget": apiType("k8s.io/apis/apps/v2", "Widget"),
+			},
+		},
+	}
+	context := &generator.Context{
+		Universe: universe,
+		Inputs:   []string{"k8s.io/apis/apps/v1beta1", "k8s.io/apis/apps/v1", "k8s.io/apis/apps/v2"},
+	}
+
+	entries, err := manifestEntries(context)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []ManifestEntry{
+		{
+			Package:    "k8s.io/apis/apps/v1",
+			Version:    "v1",
+			Kind:       "Widget",
+			Introduced: "1.23",
+		},
+		{
+			Package:      "k8s.io/apis/apps/v1beta1",
+			Version:      "v1beta1",
+			Kind:         "Gadget",
+			Introduced:   "1.21",
+			Deprecated:   "1.24",
+			Removed:      "1.27",
+			Replacements: []ManifestReplacement{{Group: "apps", Version: "v1", Kind: "Gadget"}},
+		},
+		{
+			Package:    "k8s.io/apis/apps/v1beta1",
+			Version:    "v1beta1",
+			Kind:       "Widget",
+			Introduced: "1.20",
+			Deprecated: "1.23",
+			Removed:    "1.26",
+			Replacements: []ManifestReplacement{
+				{Group: "apps", Version: "v1", Kind: "Widget"},
+				{Group: "", Version: "v1", Kind: "ConfigMap"},
+			},
+			DeprecationWarning: "use apps/v1 Widget",
+		},
+	}
+	if diff := cmp.Diff(expected, entries); diff != "" {
+		t.Error(diff)
+	}
+}
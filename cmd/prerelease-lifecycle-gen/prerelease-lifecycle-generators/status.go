@@ -42,6 +42,19 @@ const (
 	removedTagName    = tagEnabledName + ":removed"
 
 	replacementTagName = tagEnabledName + ":replacement"
+
+	deprecationWarningTagName = tagEnabledName + ":deprecation-warning"
+
+	deprecationOffsetTagName = tagEnabledName + ":deprecation-offset"
+	removalOffsetTagName     = tagEnabledName + ":removal-offset"
+)
+
+// The number of minor releases after which prerelease APIs are deprecated and
+// then removed by default, per the Kubernetes deprecation policy. Packages may
+// override them with the deprecation-offset and removal-offset tags.
+const (
+	defaultDeprecationOffset = 3
+	defaultRemovalOffset     = 3
 )
 
 // enabledTagValue holds parameters from a tagName tag.
@@ -95,40 +108,165 @@ func extractRemovedTag(t *types.Type) (*tagValue, int, int, error) {
 	return extractKubeVersionTag(removedTagName, t)
 }
 
-func extractReplacementTag(t *types.Type) (group, version, kind string, hasReplacement bool, err error) {
-	comments := append(append([]string{}, t.SecondClosestCommentLines...), t.CommentLines...)
+// packageDefaultTagNames are the lifecycle tags which may be set on a package,
+// to apply to all its types which do not set them.
+var packageDefaultTagNames = []string{introducedTagName, deprecatedTagName, removedTagName}
+
+// withPackageDefaults returns t, or a copy of t if the package comments set
+// lifecycle tags t does not set, with these tags appended to its comments.
+func withPackageDefaults(t *types.Type, packageComments []string) *types.Type {
+	packageTags := gengo.ExtractCommentTags("+", packageComments)
+	var defaults []string
+	for _, tagName := range packageDefaultTagNames {
+		if tagExists(tagName, t) {
+			continue
+		}
+		for _, value := range packageTags[tagName] {
+			defaults = append(defaults, "+"+tagName+"="+value)
+		}
+	}
+	if len(defaults) == 0 {
+		return t
+	}
+	withDefaults := *t
+	withDefaults.CommentLines = append(append([]string{}, t.CommentLines...), defaults...)
+	return &withDefaults
+}
 
-	tagVals := gengo.ExtractCommentTags("+", comments)[replacementTagName]
+// extractOffsetTags returns the number of minor releases after which the
+// prerelease APIs of a package are deprecated and then removed by default.
+func extractOffsetTags(packageComments []string) (deprecationOffset, removalOffset int, err error) {
+	deprecationOffset, err = extractOffsetTag(deprecationOffsetTagName, packageComments, defaultDeprecationOffset)
+	if err != nil {
+		return 0, 0, err
+	}
+	removalOffset, err = extractOffsetTag(removalOffsetTagName, packageComments, defaultRemovalOffset)
+	if err != nil {
+		return 0, 0, err
+	}
+	return deprecationOffset, removalOffset, nil
+}
+
+func extractOffsetTag(tagName string, packageComments []string, defaultOffset int) (int, error) {
+	tagVals := gengo.ExtractCommentTags("+", packageComments)[tagName]
 	if len(tagVals) == 0 {
-		// No match for the tag.
-		return "", "", "", false, nil
+		return defaultOffset, nil
 	}
-	// If there are multiple values, abort.
 	if len(tagVals) > 1 {
-		return "", "", "", false, fmt.Errorf("found %d %s tags: %q", len(tagVals), replacementTagName, tagVals)
+		return 0, fmt.Errorf("found %d %s tags: %q", len(tagVals), tagName, tagVals)
+	}
+	offset, err := strconv.Atoi(tagVals[0])
+	if err != nil || offset < 1 {
+		return 0, fmt.Errorf("%s value must be a positive number of minor releases, got %q", tagName, tagVals[0])
 	}
-	tagValue := tagVals[0]
+	return offset, nil
+}
+
+// replacement is a group, version and kind to be used instead of a deprecated
+// type.
+type replacement struct {
+	Group, Version, Kind string
+}
+
+func extractReplacementTag(t *types.Type) (group, version, kind string, hasReplacement bool, err error) {
+	replacements, err := extractReplacementTags(t)
+	if err != nil || len(replacements) == 0 {
+		return "", "", "", false, err
+	}
+	return replacements[0].Group, replacements[0].Version, replacements[0].Kind, true, nil
+}
+
+// extractReplacementTags returns the replacements of t, in the order of
+// their tags, which is their order of preference.
+func extractReplacementTags(t *types.Type) ([]replacement, error) {
+	comments := append(append([]string{}, t.SecondClosestCommentLines...), t.CommentLines...)
+
+	tagVals := gengo.ExtractCommentTags("+", comments)[replacementTagName]
+	replacements := make([]replacement, 0, len(tagVals))
+	for _, tagValue := range tagVals {
+		r, err := parseReplacement(tagValue)
+		if err != nil {
+			return nil, err
+		}
+		for _, prev := range replacements {
+			if prev == r {
+				return nil, fmt.Errorf("found duplicate %s tags: %q", replacementTagName, tagValue)
+			}
+		}
+		replacements = append(replacements, r)
+	}
+	return replacements, nil
+}
+
+func parseReplacement(tagValue string) (replacement, error) {
 	parts := strings.Split(tagValue, ",")
 	if len(parts) != 3 {
-		return "", "", "", false, fmt.Errorf(`%s value must be "<group>,<version>,<kind>", got %q`, replacementTagName, tagValue)
+		return replacement{}, fmt.Errorf(`%s value must be "<group>,<version>,<kind>", got %q`, replacementTagName, tagValue)
 	}
-	group, version, kind = parts[0], parts[1], parts[2]
+	group, version, kind := parts[0], parts[1], parts[2]
 	if len(version) == 0 || len(kind) == 0 {
-		return "", "", "", false, fmt.Errorf(`%s value must be "<group>,<version>,<kind>", got %q`, replacementTagName, tagValue)
+		return replacement{}, fmt.Errorf(`%s value must be "<group>,<version>,<kind>", got %q`, replacementTagName, tagValue)
 	}
 	// sanity check the group
 	if strings.ToLower(group) != group {
-		return "", "", "", false, fmt.Errorf(`replacement group must be all lower-case, got %q`, group)
+		return replacement{}, fmt.Errorf(`replacement group must be all lower-case, got %q`, group)
 	}
 	// sanity check the version
 	if !strings.HasPrefix(version, "v") || strings.ToLower(version) != version {
-		return "", "", "", false, fmt.Errorf(`replacement version must start with "v" and be all lower-case, got %q`, version)
+		return replacement{}, fmt.Errorf(`replacement version must start with "v" and be all lower-case, got %q`, version)
 	}
 	// sanity check the kind
 	if strings.ToUpper(kind[:1]) != kind[:1] {
-		return "", "", "", false, fmt.Errorf(`replacement kind must start with uppercase-letter, got %q`, kind)
+		return replacement{}, fmt.Errorf(`replacement kind must start with uppercase-letter, got %q`, kind)
+	}
+	return replacement{Group: group, Version: version, Kind: kind}, nil
+}
+
+// extractDeprecationWarningTag returns the deprecation warning template of t,
+// or else of its package, and whether it was set on t itself.
+func extractDeprecationWarningTag(t *types.Type, packageComments []string) (template string, onType bool, err error) {
+	comments := append(append([]string{}, t.SecondClosestCommentLines...), t.CommentLines...)
+	template, found, err := extractDeprecationWarning(comments)
+	if err != nil || found {
+		return template, found, err
+	}
+	template, _, err = extractDeprecationWarning(packageComments)
+	return template, false, err
+}
+
+func extractDeprecationWarning(comments []string) (string, bool, error) {
+	tagVals := gengo.ExtractCommentTags("+", comments)[deprecationWarningTagName]
+	if len(tagVals) == 0 {
+		// No match for the tag.
+		return "", false, nil
+	}
+	// If there are multiple values, abort.
+	if len(tagVals) > 1 {
+		return "", false, fmt.Errorf("found %d %s tags: %q", len(tagVals), deprecationWarningTagName, tagVals)
+	}
+	if len(strings.TrimSpace(tagVals[0])) == 0 {
+		return "", false, fmt.Errorf("%s value must not be empty", deprecationWarningTagName)
 	}
-	return group, version, kind, true, nil
+	return tagVals[0], true, nil
+}
+
+var deprecationWarningPlaceholderRegex = regexp.MustCompile(`\{([a-z]+)\}`)
+
+// expandDeprecationWarning replaces the {placeholder}s of a deprecation warning
+// template with their values, failing on unknown or unset placeholders.
+func expandDeprecationWarning(template string, values map[string]string) (string, error) {
+	var err error
+	warning := deprecationWarningPlaceholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		value, found := values[name]
+		if !found && err == nil {
+			err = fmt.Errorf("%s value %q: unknown placeholder %s", deprecationWarningTagName, template, placeholder)
+		} else if len(value) == 0 && err == nil {
+			err = fmt.Errorf("%s value %q: placeholder %s has no value", deprecationWarningTagName, template, placeholder)
+		}
+		return value
+	})
+	return warning, err
 }
 
 func extractTag(tagName string, comments []string) *tagValue {
@@ -177,6 +315,20 @@ func DefaultNameSystem() string {
 	return "public"
 }
 
+// isPackageEnabled returns whether the package-scoped tag requests generation
+// for pkg.
+func isPackageEnabled(pkg *types.Package) bool {
+	ptag := extractTag(tagEnabledName, pkg.Comments)
+	if ptag == nil {
+		return false
+	}
+	enabled, err := strconv.ParseBool(ptag.value)
+	if err != nil {
+		klog.Fatalf("Package %v: unsupported %s value: %q :%v", pkg.Path, tagEnabledName, ptag.value, err)
+	}
+	return enabled
+}
+
 // GetTargets makes the target definition.
 func GetTargets(context *generator.Context, args *args.Args) []generator.Target {
 	boilerplate, err := gengo.GoBoilerplate(args.GoHeaderFile, gengo.StdBuildTag, gengo.StdGeneratedBy)
@@ -191,14 +343,7 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 
 		pkg := context.Universe[i]
 
-		ptag := extractTag(tagEnabledName, pkg.Comments)
-		pkgNeedsGeneration := false
-		if ptag != nil {
-			pkgNeedsGeneration, err = strconv.ParseBool(ptag.value)
-			if err != nil {
-				klog.Fatalf("Package %v: unsupported %s value: %q :%v", i, tagEnabledName, ptag.value, err)
-			}
-		}
+		pkgNeedsGeneration := isPackageEnabled(pkg)
 		if !pkgNeedsGeneration {
 			klog.V(5).Infof("  skipping package")
 			continue
@@ -235,7 +380,7 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 					},
 					GeneratorsFunc: func(c *generator.Context) (generators []generator.Generator) {
 						return []generator.Generator{
-							NewPrereleaseLifecycleGen(args.OutputFile, pkg.Path),
+							NewPrereleaseLifecycleGen(args.OutputFile, pkg.Path, pkg.Comments, args.EmulationAwareRemoval),
 						}
 					},
 				})
@@ -247,20 +392,26 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 // genDeepCopy produces a file with autogenerated deep-copy functions.
 type genPreleaseLifecycle struct {
 	generator.GoGenerator
-	targetPackage string
-	imports       namer.ImportTracker
-	typesForInit  []*types.Type
+	targetPackage         string
+	packageComments       []string
+	emulationAwareRemoval bool
+	imports               namer.ImportTracker
+	typesForInit          []*types.Type
 }
 
-// NewPrereleaseLifecycleGen creates a generator for the prerelease-lifecycle-generator
-func NewPrereleaseLifecycleGen(outputFilename, targetPackage string) generator.Generator {
+// NewPrereleaseLifecycleGen creates a generator for the prerelease-lifecycle-generator.
+// The package comments hold the tags applying to all the types of the package.
+// With emulationAwareRemoval, APILifecycleRemovedIn methods are generated too.
+func NewPrereleaseLifecycleGen(outputFilename, targetPackage string, packageComments []string, emulationAwareRemoval bool) generator.Generator {
 	return &genPreleaseLifecycle{
 		GoGenerator: generator.GoGenerator{
 			OutputFilename: outputFilename,
 		},
-		targetPackage: targetPackage,
-		imports:       generator.NewImportTracker(),
-		typesForInit:  make([]*types.Type, 0),
+		targetPackage:         targetPackage,
+		packageComments:       packageComments,
+		emulationAwareRemoval: emulationAwareRemoval,
+		imports:               generator.NewImportTracker(),
+		typesForInit:          make([]*types.Type, 0),
 	}
 }
 
@@ -282,6 +433,15 @@ func (g *genPreleaseLifecycle) Filter(c *generator.Context, t *types.Type) bool
 	return true
 }
 
+// lifecycleMethodSignatures holds the number of parameters and results of the
+// lifecycle methods not returning a major and minor version.
+var lifecycleMethodSignatures = map[string]struct{ parameters, results int }{
+	"APILifecycleReplacement":        {0, 1},
+	"APILifecycleReplacements":       {0, 1},
+	"APILifecycleDeprecationWarning": {0, 1},
+	"APILifecycleRemovedIn":          {1, 1},
+}
+
 // versionMethod returns the signature of an <methodName>() method, nil or an error
 // if the type is wrong. Introduced() allows more efficient deep copy
 // implementations to be defined by the type's author.  The correct signature
@@ -292,11 +452,15 @@ func versionMethod(methodName string, t *types.Type) (*types.Signature, error) {
 	if !found {
 		return nil, nil
 	}
-	if len(f.Signature.Parameters) != 0 {
-		return nil, fmt.Errorf("type %v: invalid  %v signature, expected no parameters", t, methodName)
+	parameters, results := 0, 2
+	if signature, found := lifecycleMethodSignatures[methodName]; found {
+		parameters, results = signature.parameters, signature.results
 	}
-	if len(f.Signature.Results) != 2 {
-		return nil, fmt.Errorf("type %v: invalid  %v signature, expected exactly two result types", t, methodName)
+	if len(f.Signature.Parameters) != parameters {
+		return nil, fmt.Errorf("type %v: invalid  %v signature, expected exactly %d parameters", t, methodName, parameters)
+	}
+	if len(f.Signature.Results) != results {
+		return nil, fmt.Errorf("type %v: invalid  %v signature, expected exactly %d result types", t, methodName, results)
 	}
 
 	ptrRcvr := f.Signature.Receiver != nil && f.Signature.Receiver.Kind == types.Pointer && f.Signature.Receiver.Elem.Name == t.Name
@@ -373,6 +537,9 @@ func (g *genPreleaseLifecycle) argsFromType(c *generator.Context, t *types.Type)
 	a := generator.Args{
 		"type": t,
 	}
+	// The lifecycle tags are read from the type, and from the package
+	// otherwise, but a is about the type itself.
+	t = withPackageDefaults(t, g.packageComments)
 	_, introducedMajor, introducedMinor, err := extractIntroducedTag(t)
 	if err != nil {
 		return nil, err
@@ -389,11 +556,15 @@ func (g *genPreleaseLifecycle) argsFromType(c *generator.Context, t *types.Type)
 		With("introducedMinor", introducedMinor)
 
 	// compute based on our policy
+	deprecationOffset, removalOffset, err := extractOffsetTags(g.packageComments)
+	if err != nil {
+		return nil, err
+	}
 	hasDeprecated := tagExists(deprecatedTagName, t)
 	hasRemoved := tagExists(removedTagName, t)
 
 	deprecatedMajor := introducedMajor
-	deprecatedMinor := introducedMinor + 3
+	deprecatedMinor := introducedMinor + deprecationOffset
 	// if someone intentionally override the deprecation release
 	if hasDeprecated {
 		_, deprecatedMajor, deprecatedMinor, err = extractDeprecatedTag(t)
@@ -410,7 +581,7 @@ func (g *genPreleaseLifecycle) argsFromType(c *generator.Context, t *types.Type)
 
 	// compute based on our policy
 	removedMajor := deprecatedMajor
-	removedMinor := deprecatedMinor + 3
+	removedMinor := deprecatedMinor + removalOffset
 	// if someone intentionally override the removed release
 	if hasRemoved {
 		_, removedMajor, removedMinor, err = extractRemovedTag(t)
@@ -425,23 +596,66 @@ func (g *genPreleaseLifecycle) argsFromType(c *generator.Context, t *types.Type)
 			With("removedMinor", removedMinor)
 	}
 
-	replacementGroup, replacementVersion, replacementKind, hasReplacement, err := extractReplacementTag(t)
+	replacements, err := extractReplacementTags(t)
 	if err != nil {
 		return nil, err
 	}
+	hasReplacement := len(replacements) > 0
 	if hasReplacement {
-		gvkType := c.Universe.Type(types.Name{Package: "k8s.io/apimachinery/pkg/runtime/schema", Name: "GroupVersionKind"})
+		gvkType := types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupVersionKind")
 		g.imports.AddType(gvkType)
 		a = a.
-			With("replacementGroup", replacementGroup).
-			With("replacementVersion", replacementVersion).
-			With("replacementKind", replacementKind).
+			With("replacementGroup", replacements[0].Group).
+			With("replacementVersion", replacements[0].Version).
+			With("replacementKind", replacements[0].Kind).
 			With("GroupVersionKind", gvkType)
+		if len(replacements) > 1 {
+			a = a.With("replacements", replacements)
+		}
+	}
+
+	warningTemplate, warningOnType, err := extractDeprecationWarningTag(t, g.packageComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(warningTemplate) > 0 {
+		if _, hasDeprecated := a["deprecatedMajor"]; hasDeprecated {
+			values := map[string]string{
+				"kind":        t.Name.Name,
+				"version":     version,
+				"introduced":  fmt.Sprintf("%d.%d", introducedMajor, introducedMinor),
+				"deprecated":  fmt.Sprintf("%d.%d", deprecatedMajor, deprecatedMinor),
+				"removed":     "",
+				"replacement": "",
+			}
+			if _, hasRemoved := a["removedMajor"]; hasRemoved {
+				values["removed"] = fmt.Sprintf("%d.%d", removedMajor, removedMinor)
+			}
+			if hasReplacement {
+				values["replacement"] = schemaGroupVersion(replacements[0].Group, replacements[0].Version) + " " + replacements[0].Kind
+			}
+			warning, err := expandDeprecationWarning(warningTemplate, values)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %w", t, err)
+			}
+			a = a.With("deprecationWarning", warning)
+		} else if warningOnType {
+			return nil, fmt.Errorf("%v has a %s tag but is never deprecated", t, deprecationWarningTagName)
+		}
 	}
 
 	return a, nil
 }
 
+// schemaGroupVersion formats a group and version like schema.GroupVersion does,
+// which is how the API server formats replacements in its deprecation warnings.
+func schemaGroupVersion(group, version string) string {
+	if len(group) == 0 {
+		return version
+	}
+	return group + "/" + version
+}
+
 func (g *genPreleaseLifecycle) Init(c *generator.Context, w io.Writer) error {
 	return nil
 }
@@ -454,6 +668,10 @@ func (g *genPreleaseLifecycle) GenerateType(c *generator.Context, t *types.Type,
 	if err != nil {
 		return err
 	}
+	deprecationOffset, removalOffset, err := extractOffsetTags(g.packageComments)
+	if err != nil {
+		return err
+	}
 
 	if versionedMethodOrDie("APILifecycleIntroduced", t) == nil {
 		sw.Do("// APILifecycleIntroduced is an autogenerated function, returning the release in which the API struct was introduced as int versions of major and minor for comparison.\n", args)
@@ -466,7 +684,7 @@ func (g *genPreleaseLifecycle) GenerateType(c *generator.Context, t *types.Type,
 	if _, hasDeprecated := args["deprecatedMajor"]; hasDeprecated {
 		if versionedMethodOrDie("APILifecycleDeprecated", t) == nil {
 			sw.Do("// APILifecycleDeprecated is an autogenerated function, returning the release in which the API struct was or will be deprecated as int versions of major and minor for comparison.\n", args)
-			sw.Do("// It is controlled by \""+deprecatedTagName+"\" tags in types.go or  \""+introducedTagName+"\" plus three minor.\n", args)
+			sw.Do("// It is controlled by \""+deprecatedTagName+"\" tags in types.go or  \""+introducedTagName+"\" plus "+minorReleases(deprecationOffset)+" minor.\n", args)
 			sw.Do("func (in *$.type|intrapackage$) APILifecycleDeprecated() (major, minor int) {\n", args)
 			sw.Do("    return $.deprecatedMajor$, $.deprecatedMinor$\n", args)
 			sw.Do("}\n\n", nil)
@@ -483,15 +701,61 @@ func (g *genPreleaseLifecycle) GenerateType(c *generator.Context, t *types.Type,
 		}
 	}
 
+	if replacements, hasReplacements := args["replacements"]; hasReplacements {
+		if versionedMethodOrDie("APILifecycleReplacements", t) == nil {
+			sw.Do("// APILifecycleReplacements is an autogenerated function, returning the groups, versions, and kinds that should be used instead of this deprecated type, in order of preference.\n", args)
+			sw.Do("// It is controlled by \""+replacementTagName+"=<group>,<version>,<kind>\" tags in types.go, the first of which is returned by APILifecycleReplacement.\n", args)
+			sw.Do("func (in *$.type|intrapackage$) APILifecycleReplacements() []$.GroupVersionKind|raw$ {\n", args)
+			sw.Do("    return []$.GroupVersionKind|raw${\n", args)
+			for _, r := range replacements.([]replacement) {
+				sw.Do("        {Group:\"$.group$\", Version:\"$.version$\", Kind:\"$.kind$\"},\n", generator.Args{"group": r.Group, "version": r.Version, "kind": r.Kind})
+			}
+			sw.Do("    }\n", nil)
+			sw.Do("}\n\n", nil)
+		}
+	}
+
+	if warning, hasWarning := args["deprecationWarning"]; hasWarning {
+		if versionedMethodOrDie("APILifecycleDeprecationWarning", t) == nil {
+			sw.Do("// APILifecycleDeprecationWarning is an autogenerated function, returning a custom deprecation warning for this deprecated type.\n", args)
+			sw.Do("// It is controlled by \""+deprecationWarningTagName+"\" tags in types.go or doc.go. It is not part of the interfaces\n", args)
+			sw.Do("// the apiserver checks, so it is only used by the callers which look it up themselves.\n", args)
+			sw.Do("func (in *$.type|intrapackage$) APILifecycleDeprecationWarning() string {\n", args)
+			sw.Do("    return $.quotedWarning$\n", args.With("quotedWarning", strconv.Quote(warning.(string))))
+			sw.Do("}\n\n", nil)
+		}
+	}
+
 	if _, hasRemoved := args["removedMajor"]; hasRemoved {
 		if versionedMethodOrDie("APILifecycleRemoved", t) == nil {
 			sw.Do("// APILifecycleRemoved is an autogenerated function, returning the release in which the API is no longer served as int versions of major and minor for comparison.\n", args)
-			sw.Do("// It is controlled by \""+removedTagName+"\" tags in types.go or  \""+deprecatedTagName+"\" plus three minor.\n", args)
+			sw.Do("// It is controlled by \""+removedTagName+"\" tags in types.go or  \""+deprecatedTagName+"\" plus "+minorReleases(removalOffset)+" minor.\n", args)
 			sw.Do("func (in *$.type|intrapackage$) APILifecycleRemoved() (major, minor int) {\n", args)
 			sw.Do("    return $.removedMajor$, $.removedMinor$\n", args)
 			sw.Do("}\n\n", nil)
 		}
+
+		if g.emulationAwareRemoval && versionedMethodOrDie("APILifecycleRemovedIn", t) == nil {
+			sw.Do("// APILifecycleRemovedIn is an autogenerated function, returning whether the API is no longer served in the given version, e.g. the\n", args)
+			sw.Do("// emulated version of a component, rather than in the version of its binary. It is based on APILifecycleRemoved.\n", args)
+			sw.Do("func (in *$.type|intrapackage$) APILifecycleRemovedIn(version interface {\n", args)
+			sw.Do("    Major() uint\n", nil)
+			sw.Do("    Minor() uint\n", nil)
+			sw.Do("}) bool {\n", nil)
+			sw.Do("    major, minor := in.APILifecycleRemoved()\n", nil)
+			sw.Do("    return version.Major() > uint(major) || (version.Major() == uint(major) && version.Minor() >= uint(minor))\n", nil)
+			sw.Do("}\n\n", nil)
+		}
 	}
 
 	return sw.Error()
 }
+
+// minorReleases spells out the default offsets in the comments of the
+// generated functions.
+func minorReleases(offset int) string {
+	if offset == 3 {
+		return "three"
+	}
+	return strconv.Itoa(offset)
+}
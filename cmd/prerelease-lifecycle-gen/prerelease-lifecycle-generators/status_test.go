@@ -39,10 +39,11 @@ var mockType = &types.Type{
 
 func TestArgsFromType(t *testing.T) {
 	type testcase struct {
-		name          string
-		t             *types.Type
-		expected      generator.Args
-		expectedError string
+		name            string
+		t               *types.Type
+		packageComments []string
+		expected        generator.Args
+		expectedError   string
 	}
 
 	tests := []testcase{
@@ -252,6 +253,256 @@ func TestArgsFromType(t *testing.T) {
 				"removedMinor":    11,
 			},
 		},
+		{
+			name: "beta type - package defaults",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1beta1",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen=true",
+				"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+				"+k8s:prerelease-lifecycle-gen:removed=1.12",
+			},
+			expected: generator.Args{
+				"introducedMajor": 1,
+				"introducedMinor": 5,
+				"deprecatedMajor": 1,
+				"deprecatedMinor": 8,
+				"removedMajor":    1,
+				"removedMinor":    12,
+			},
+		},
+		{
+			name: "beta type - overridden package defaults",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1beta1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.6",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.7",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+				"+k8s:prerelease-lifecycle-gen:deprecated=1.8",
+			},
+			expected: generator.Args{
+				"introducedMajor": 1,
+				"introducedMinor": 6,
+				"deprecatedMajor": 1,
+				"deprecatedMinor": 7,
+				"removedMajor":    1,
+				"removedMinor":    10,
+			},
+		},
+		{
+			name: "GA type - package deprecated",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen:deprecated=1.9",
+			},
+			expected: generator.Args{
+				"introducedMajor": 1,
+				"introducedMinor": 5,
+				"deprecatedMajor": 1,
+				"deprecatedMinor": 9,
+			},
+		},
+		{
+			name: "GA type - invalid package default",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen:introduced=1",
+			},
+			expectedError: "format must match",
+		},
+		{
+			name: "beta type - package offsets",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1beta1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen:deprecation-offset=2",
+				"+k8s:prerelease-lifecycle-gen:removal-offset=6",
+			},
+			expected: generator.Args{
+				"introducedMajor": 1,
+				"introducedMinor": 5,
+				"deprecatedMajor": 1,
+				"deprecatedMinor": 7,
+				"removedMajor":    1,
+				"removedMinor":    13,
+			},
+		},
+		{
+			name: "beta type - invalid package offset",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1beta1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen:removal-offset=0",
+			},
+			expectedError: "must be a positive number",
+		},
+		{
+			name: "beta type - replacements",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1beta1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+					"+k8s:prerelease-lifecycle-gen:replacement=apps,v1,Simple",
+					"+k8s:prerelease-lifecycle-gen:replacement=,v1,Complex",
+					"+k8s:prerelease-lifecycle-gen:deprecation-warning=use {replacement}",
+				},
+			},
+			expected: generator.Args{
+				"introducedMajor":    1,
+				"introducedMinor":    5,
+				"deprecatedMajor":    1,
+				"deprecatedMinor":    8,
+				"removedMajor":       1,
+				"removedMinor":       11,
+				"replacementGroup":   "apps",
+				"replacementVersion": "v1",
+				"replacementKind":    "Simple",
+				"GroupVersionKind":   types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupVersionKind"),
+				"replacements": []replacement{
+					{Group: "apps", Version: "v1", Kind: "Simple"},
+					{Group: "", Version: "v1", Kind: "Complex"},
+				},
+				"deprecationWarning": "use apps/v1 Simple",
+			},
+		},
+		{
+			name: "beta type - deprecation warning",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1beta1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+					"+k8s:prerelease-lifecycle-gen:deprecation-warning={version} {kind} is deprecated since {deprecated}, and unavailable from {removed}",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen:deprecation-warning=overridden",
+			},
+			expected: generator.Args{
+				"introducedMajor":    1,
+				"introducedMinor":    5,
+				"deprecatedMajor":    1,
+				"deprecatedMinor":    8,
+				"removedMajor":       1,
+				"removedMinor":       11,
+				"deprecationWarning": "v1beta1 Simple is deprecated since 1.8, and unavailable from 1.11",
+			},
+		},
+		{
+			name: "beta type - package deprecation warning",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1beta1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen:deprecation-warning={kind} introduced in {introduced} is deprecated",
+			},
+			expected: generator.Args{
+				"introducedMajor":    1,
+				"introducedMinor":    5,
+				"deprecatedMajor":    1,
+				"deprecatedMinor":    8,
+				"removedMajor":       1,
+				"removedMinor":       11,
+				"deprecationWarning": "Simple introduced in 1.5 is deprecated",
+			},
+		},
+		{
+			name: "GA type - package deprecation warning",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+				},
+			},
+			packageComments: []string{
+				"+k8s:prerelease-lifecycle-gen:deprecation-warning={kind} is deprecated",
+			},
+			expected: generator.Args{
+				"introducedMajor": 1,
+				"introducedMinor": 5,
+			},
+		},
+		{
+			name: "GA type - deprecation warning",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+					"+k8s:prerelease-lifecycle-gen:deprecation-warning={kind} is deprecated",
+				},
+			},
+			expectedError: "never deprecated",
+		},
+		{
+			name: "GA type - deprecation warning without removal",
+			t: &types.Type{
+				Name: types.Name{
+					Name:    "Simple",
+					Package: "k8s.io/apis/core/v1",
+				},
+				CommentLines: []string{
+					"+k8s:prerelease-lifecycle-gen:introduced=1.5",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.7",
+					"+k8s:prerelease-lifecycle-gen:deprecation-warning={kind} is removed in {removed}",
+				},
+			},
+			expectedError: "placeholder {removed} has no value",
+		},
 	}
 
 	for _, test := range tests {
@@ -259,7 +510,10 @@ func TestArgsFromType(t *testing.T) {
 			if test.expected != nil {
 				test.expected["type"] = test.t
 			}
-			gen := genPreleaseLifecycle{}
+			gen := genPreleaseLifecycle{
+				packageComments: test.packageComments,
+				imports:         generator.NewImportTracker(),
+			}
 			args, err := gen.argsFromType(nil, test.t)
 			if test.expectedError != "" {
 				if err == nil {
@@ -735,6 +989,187 @@ func Test_extractReplacementTag(t *testing.T) {
 	}
 }
 
+func Test_extractReplacementTags(t *testing.T) {
+	replacementTag := "+k8s:prerelease-lifecycle-gen:replacement"
+	tests := []struct {
+		name     string
+		comments []string
+		want     []replacement
+		wantErr  bool
+	}{
+		{
+			name:     "no replacement tag",
+			comments: []string{"randomText=7"},
+			want:     []replacement{},
+		},
+		{
+			name: "replacements in order of preference",
+			comments: []string{
+				fmt.Sprintf("%v=my_group,v1,KindOf", replacementTag),
+				fmt.Sprintf("%v=other_group,v2,OtherKind", replacementTag),
+			},
+			want: []replacement{
+				{Group: "my_group", Version: "v1", Kind: "KindOf"},
+				{Group: "other_group", Version: "v2", Kind: "OtherKind"},
+			},
+		},
+		{
+			name: "invalid fallback",
+			comments: []string{
+				fmt.Sprintf("%v=my_group,v1,KindOf", replacementTag),
+				fmt.Sprintf("%v=other_group,v2", replacementTag),
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate replacements",
+			comments: []string{
+				fmt.Sprintf("%v=my_group,v1,KindOf", replacementTag),
+				fmt.Sprintf("%v=my_group,v1,KindOf", replacementTag),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extractReplacementTags(&types.Type{CommentLines: tt.comments})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("extractReplacementTags() err got = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_expandDeprecationWarning(t *testing.T) {
+	values := map[string]string{
+		"kind":        "Flunder",
+		"version":     "v1beta1",
+		"deprecated":  "1.22",
+		"removed":     "",
+		"replacement": "wardle.example.com/v1 Flunder",
+	}
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "no placeholder",
+			template: "this API is deprecated",
+			want:     "this API is deprecated",
+		},
+		{
+			name:     "placeholders",
+			template: "{version} {kind} is deprecated in {deprecated}; use {replacement}",
+			want:     "v1beta1 Flunder is deprecated in 1.22; use wardle.example.com/v1 Flunder",
+		},
+		{
+			name:     "repeated placeholder",
+			template: "{kind}, {kind}",
+			want:     "Flunder, Flunder",
+		},
+		{
+			name:     "unknown placeholder",
+			template: "{kind} is deprecated in {release}",
+			wantErr:  "unknown placeholder {release}",
+		},
+		{
+			name:     "unset placeholder",
+			template: "{kind} is removed in {removed}",
+			wantErr:  "placeholder {removed} has no value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandDeprecationWarning(tt.template, values)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expandDeprecationWarning() err got = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expandDeprecationWarning() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expandDeprecationWarning() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_versionMethod(t *testing.T) {
+	typeName := types.Name{Package: "k8s.io/apis/core/v1beta1", Name: "Simple"}
+	receiver := &types.Type{Kind: types.Pointer, Elem: &types.Type{Name: typeName}}
+	method := func(parameters, results int) *types.Type {
+		signature := &types.Signature{Receiver: receiver}
+		for i := 0; i < parameters; i++ {
+			signature.Parameters = append(signature.Parameters, &types.ParamResult{Type: types.String})
+		}
+		for i := 0; i < results; i++ {
+			signature.Results = append(signature.Results, &types.ParamResult{Type: types.Int})
+		}
+		return &types.Type{Kind: types.Func, Signature: signature}
+	}
+	tests := []struct {
+		name       string
+		methodName string
+		method     *types.Type
+		wantErr    bool
+	}{
+		{
+			name:       "version",
+			methodName: "APILifecycleRemoved",
+			method:     method(0, 2),
+		},
+		{
+			name:       "version with parameter",
+			methodName: "APILifecycleRemoved",
+			method:     method(1, 2),
+			wantErr:    true,
+		},
+		{
+			name:       "replacement",
+			methodName: "APILifecycleReplacement",
+			method:     method(0, 1),
+		},
+		{
+			name:       "removed in version",
+			methodName: "APILifecycleRemovedIn",
+			method:     method(1, 1),
+		},
+		{
+			name:       "removed in without version",
+			methodName: "APILifecycleRemovedIn",
+			method:     method(0, 1),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ := &types.Type{
+				Name:    typeName,
+				Methods: map[string]*types.Type{tt.methodName: tt.method},
+			}
+			signature, err := versionMethod(tt.methodName, typ)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("versionMethod() err got = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && signature != tt.method.Signature {
+				t.Errorf("versionMethod() got = %v, want %v", signature, tt.method.Signature)
+			}
+		})
+	}
+}
+
 func Test_isAPIType(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prereleaselifecyclegenerators
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+// Verify returns the errors in the lifecycle of the API types of the input
+// packages requesting generation: missing introduced tags, invalid tags,
+// releases out of order, and prerelease APIs outliving the deprecation
+// policy, i.e. deprecated or removed later than by default, with the offsets
+// of their package.
+func Verify(context *generator.Context) []string {
+	var findings []string
+	for _, i := range context.Inputs {
+		pkg := context.Universe[i]
+		if !isPackageEnabled(pkg) {
+			continue
+		}
+		g := &genPreleaseLifecycle{
+			targetPackage:   pkg.Path,
+			packageComments: pkg.Comments,
+			imports:         generator.NewImportTracker(),
+		}
+		names := make([]string, 0, len(pkg.Types))
+		for name := range pkg.Types {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			t := pkg.Types[name]
+			if !isAPIType(t) {
+				continue
+			}
+			for _, problem := range g.verifyType(context, t) {
+				findings = append(findings, fmt.Sprintf("%v: %s", t, problem))
+			}
+		}
+	}
+	return findings
+}
+
+// verifyType returns the problems with the lifecycle of t.
+func (g *genPreleaseLifecycle) verifyType(c *generator.Context, t *types.Type) []string {
+	if !tagExists(introducedTagName, withPackageDefaults(t, g.packageComments)) {
+		return []string{fmt.Sprintf("served type is missing a %s tag", introducedTagName)}
+	}
+	a, err := g.argsFromType(c, t)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	release := func(name string) (major, minor int, found bool) {
+		if _, found := a[name+"Major"]; !found {
+			return 0, 0, false
+		}
+		return a[name+"Major"].(int), a[name+"Minor"].(int), true
+	}
+	introducedMajor, introducedMinor, _ := release("introduced")
+	deprecatedMajor, deprecatedMinor, hasDeprecated := release("deprecated")
+	removedMajor, removedMinor, hasRemoved := release("removed")
+
+	var problems []string
+	if hasDeprecated && compareReleases(deprecatedMajor, deprecatedMinor, introducedMajor, introducedMinor) < 0 {
+		problems = append(problems, fmt.Sprintf("deprecated in %d.%d, before being introduced in %d.%d",
+			deprecatedMajor, deprecatedMinor, introducedMajor, introducedMinor))
+	}
+	if hasRemoved && compareReleases(removedMajor, removedMinor, introducedMajor, introducedMinor) <= 0 {
+		problems = append(problems, fmt.Sprintf("removed in %d.%d, not after being introduced in %d.%d",
+			removedMajor, removedMinor, introducedMajor, introducedMinor))
+	}
+	if hasDeprecated && hasRemoved && compareReleases(removedMajor, removedMinor, deprecatedMajor, deprecatedMinor) <= 0 {
+		problems = append(problems, fmt.Sprintf("removed in %d.%d, not after being deprecated in %d.%d",
+			removedMajor, removedMinor, deprecatedMajor, deprecatedMinor))
+	}
+	if len(problems) > 0 || isGAVersionRegex.MatchString(path.Base(t.Name.Package)) {
+		return problems
+	}
+
+	// Prerelease APIs must not be served longer than the policy allows.
+	deprecationOffset, removalOffset, err := extractOffsetTags(g.packageComments)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	if compareReleases(deprecatedMajor, deprecatedMinor, introducedMajor, introducedMinor+deprecationOffset) > 0 {
+		problems = append(problems, fmt.Sprintf("prerelease API deprecated in %d.%d, more than %d releases after being introduced in %d.%d",
+			deprecatedMajor, deprecatedMinor, deprecationOffset, introducedMajor, introducedMinor))
+	}
+	if compareReleases(removedMajor, removedMinor, deprecatedMajor, deprecatedMinor+removalOffset) > 0 {
+		problems = append(problems, fmt.Sprintf("prerelease API removed in %d.%d, more than %d releases after being deprecated in %d.%d",
+			removedMajor, removedMinor, removalOffset, deprecatedMajor, deprecatedMinor))
+	}
+	return problems
+}
+
+// compareReleases returns -1, 0 or 1 if the release majorA.minorA is before,
+// the same as, or after majorB.minorB.
+func compareReleases(majorA, minorA, majorB, minorB int) int {
+	switch {
+	case majorA < majorB:
+		return -1
+	case majorA > majorB:
+		return 1
+	case minorA < minorB:
+		return -1
+	case minorA > minorB:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prereleaselifecyclegenerators
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/types"
+)
+
+func TestVerify(t *testing.T) {
+	apiType := func(pkg, name string, comments ...string) *types.Type {
+		return &types.Type{
+			Name:         types.Name{Package: pkg, Name: name},
+			Kind:         types.Struct,
+			Members:      []types.Member{{Name: "TypeMeta", Embedded: true}},
+			CommentLines: comments,
+		}
+	}
+	const beta = "k8s.io/apis/apps/v1beta1"
+	const ga = "k8s.io/apis/apps/v1"
+	universe := types.Universe{
+		beta: &types.Package{
+			Path:     beta,
+			Comments: []string{"+k8s:prerelease-lifecycle-gen=true"},
+			Types: map[string]*types.Type{
+				"Defaulted": apiType(beta, "Defaulted",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+				),
+				"Missing": apiType(beta, "Missing"),
+				"Invalid": apiType(beta, "Invalid",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.a",
+				),
+				"Early": apiType(beta, "Early",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.19",
+				),
+				"Late": apiType(beta, "Late",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.24",
+					"+k8s:prerelease-lifecycle-gen:removed=1.28",
+				),
+				"Inverted": apiType(beta, "Inverted",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.22",
+					"+k8s:prerelease-lifecycle-gen:removed=1.22",
+				),
+				"Shortened": apiType(beta, "Shortened",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.21",
+					"+k8s:prerelease-lifecycle-gen:removed=1.22",
+				),
+			},
+		},
+		ga: &types.Package{
+			Path:     ga,
+			Comments: []string{"+k8s:prerelease-lifecycle-gen=true"},
+			Types: map[string]*types.Type{
+				"Served": apiType(ga, "Served",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.30",
+				),
+				"Removed": apiType(ga, "Removed",
+					"+k8s:prerelease-lifecycle-gen:introduced=2.0",
+					"+k8s:prerelease-lifecycle-gen:removed=1.30",
+				),
+			},
+		},
+		"k8s.io/apis/batch/v1beta1": &types.Package{
+			Path: "k8s.io/apis/batch/v1beta1",
+			Comments: []string{
+				"+k8s:prerelease-lifecycle-gen=true",
+				"+k8s:prerelease-lifecycle-gen:deprecation-offset=6",
+				"+k8s:prerelease-lifecycle-gen:removal-offset=1",
+			},
+			Types: map[string]*types.Type{
+				"Long": apiType("k8s.io/apis/batch/v1beta1", "Long",
+					"+k8s:prerelease-lifecycle-gen:introduced=1.20",
+					"+k8s:prerelease-lifecycle-gen:deprecated=1.26",
+					"+k8s:prerelease-lifecycle-gen:removed=1.28",
+				),
+			},
+		},
+		"k8s.io/apis/apps/v2": &types.Package{
+			Path: "k8s.io/apis/apps/v2",
+			Types: map[string]*types.Type{
+				"Missing": apiType("k8s.io/apis/apps/v2", "Missing"),
+			},
+		},
+	}
+	context := &generator.Context{
+		Universe: universe,
+		Inputs:   []string{beta, ga, "k8s.io/apis/batch/v1beta1", "k8s.io/apis/apps/v2"},
+	}
+
+	expected := []string{
+		"k8s.io/apis/apps/v1beta1.Early: deprecated in 1.19, before being introduced in 1.20",
+		"k8s.io/apis/apps/v1beta1.Invalid: k8s.io/apis/apps/v1beta1.Invalid format must match k8s:prerelease-lifecycle-gen:introduced=xx.yy : strconv.ParseInt: parsing \"a\": invalid syntax",
+		"k8s.io/apis/apps/v1beta1.Inverted: removed in 1.22, not after being deprecated in 1.22",
+		"k8s.io/apis/apps/v1beta1.Late: prerelease API deprecated in 1.24, more than 3 releases after being introduced in 1.20",
+		"k8s.io/apis/apps/v1beta1.Late: prerelease API removed in 1.28, more than 3 releases after being deprecated in 1.24",
+		"k8s.io/apis/apps/v1beta1.Missing: served type is missing a k8s:prerelease-lifecycle-gen:introduced tag",
+		"k8s.io/apis/apps/v1.Removed: removed in 1.30, not after being introduced in 2.0",
+		"k8s.io/apis/batch/v1beta1.Long: prerelease API removed in 1.28, more than 1 releases after being deprecated in 1.26",
+	}
+	if diff := cmp.Diff(expected, Verify(context)); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -25,6 +25,39 @@ import (
 type Args struct {
 	OutputFile   string
 	GoHeaderFile string
+
+	// KindHelpers, if true, also generates the Kind functions and the
+	// GroupVersionKind and GroupVersionResource variables of the registered
+	// types.
+	KindHelpers bool
+
+	// PluralExceptions specify list of exceptions used when pluralizing certain types.
+	// For example 'Endpoints:Endpoints', otherwise the pluralizer will generate 'Endpointes'.
+	PluralExceptions []string
+
+	// InstallOutputFile, if set, is the name of the file to generate in the
+	// install package of each group, which registers all the versions of the
+	// group into a scheme.
+	InstallOutputFile string
+
+	// CodecsOutputFile, if set, is the name of the file to generate in the
+	// install package of each group, which holds the scheme of the group and
+	// its codecs.
+	CodecsOutputFile string
+
+	// RegisterGeneratedFuncs, if true, registers the functions generated by
+	// conversion-gen and defaulter-gen for the packages tagged for them with
+	// the SchemeBuilder.
+	RegisterGeneratedFuncs bool
+
+	// Strict, if true, fails the generation if a type implementing
+	// runtime.Object, or the List type of a type with a client, is not
+	// registered.
+	Strict bool
+
+	// RegisterInternal, if true, also generates the registration of the
+	// internal packages, whose types have no json tags.
+	RegisterInternal bool
 }
 
 // New returns default arguments for the generator.
@@ -38,6 +71,20 @@ func (args *Args) AddFlags(fs *pflag.FlagSet) {
 		"the name of the file to be generated")
 	fs.StringVar(&args.GoHeaderFile, "go-header-file", "",
 		"the path to a file containing boilerplate header text; the string \"YEAR\" will be replaced with the current 4-digit year")
+	fs.BoolVar(&args.KindHelpers, "kind-helpers", false,
+		"also generate the Kind function qualifying kinds with the group, and the <Type>GroupVersionKind and <Type>GroupVersionResource variables of the registered types")
+	fs.StringSliceVar(&args.PluralExceptions, "plural-exceptions", args.PluralExceptions,
+		"list of comma separated plural exception definitions in Type:PluralizedType format, used to name the resources of the GroupVersionResources generated with --kind-helpers")
+	fs.StringVar(&args.InstallOutputFile, "install-output-file", "",
+		"the name of the file to generate in the install package of each group, registering the internal and external versions of the group into a scheme in priority order, if any")
+	fs.StringVar(&args.CodecsOutputFile, "codecs-output-file", "",
+		"the name of the file to generate in the install package of each group, holding a scheme the group is installed into, its codec factory, parameter codec, and encoder and decoder helpers, if any; requires --install-output-file")
+	fs.BoolVar(&args.RegisterGeneratedFuncs, "register-generated-funcs", false,
+		"register the conversions and defaulters generated for the packages tagged for conversion-gen and defaulter-gen with the SchemeBuilder, so that AddToScheme includes them all")
+	fs.BoolVar(&args.RegisterInternal, "register-internal", false,
+		"also generate the registration of the types of internal packages, i.e. whose TypeMeta has no json tag, into the internal version of their group, named after the package")
+	fs.BoolVar(&args.Strict, "strict", false,
+		"fail if an exported type implementing runtime.Object, or the List type of a type tagged with +genclient, would not be registered, e.g. because it does not embed TypeMeta")
 }
 
 // Validate checks the given arguments.
@@ -45,6 +92,9 @@ func (args *Args) Validate() error {
 	if len(args.OutputFile) == 0 {
 		return fmt.Errorf("output file base name cannot be empty")
 	}
+	if len(args.CodecsOutputFile) > 0 && len(args.InstallOutputFile) == 0 {
+		return fmt.Errorf("--codecs-output-file requires --install-output-file")
+	}
 
 	return nil
 }
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"io"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+)
+
+// codecsGenerator produces the scheme of an API group, with its codec factory,
+// parameter codec and encoder and decoder helpers, in the install package of
+// the group.
+type codecsGenerator struct {
+	generator.GoGenerator
+	outputPackage string
+	// internal is whether the group has an internal version.
+	internal bool
+	imports  namer.ImportTracker
+}
+
+var _ generator.Generator = &codecsGenerator{}
+
+func (g *codecsGenerator) Filter(_ *generator.Context, _ *types.Type) bool {
+	return false
+}
+
+func (g *codecsGenerator) Imports(c *generator.Context) (imports []string) {
+	return g.imports.ImportLines()
+}
+
+func (g *codecsGenerator) Namers(_ *generator.Context) namer.NameSystems {
+	return namer.NameSystems{
+		"raw": namer.NewRawNamer(g.outputPackage, g.imports),
+	}
+}
+
+func (g *codecsGenerator) Init(context *generator.Context, w io.Writer) error {
+	sw := generator.NewSnippetWriter(w, context, "$", "$")
+	m := map[string]interface{}{
+		"internal":                   g.internal,
+		"newScheme":                  types.Ref("k8s.io/apimachinery/pkg/runtime", "NewScheme"),
+		"newParameterCodec":          types.Ref("k8s.io/apimachinery/pkg/runtime", "NewParameterCodec"),
+		"encoder":                    types.Ref("k8s.io/apimachinery/pkg/runtime", "Encoder"),
+		"decoder":                    types.Ref("k8s.io/apimachinery/pkg/runtime", "Decoder"),
+		"groupVersioner":             types.Ref("k8s.io/apimachinery/pkg/runtime", "GroupVersioner"),
+		"serializerInfoForMediaType": types.Ref("k8s.io/apimachinery/pkg/runtime", "SerializerInfoForMediaType"),
+		"groupVersion":               types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupVersion"),
+		"newCodecFactory":            types.Ref("k8s.io/apimachinery/pkg/runtime/serializer", "NewCodecFactory"),
+		"errorf":                     types.Ref("fmt", "Errorf"),
+	}
+	sw.Do(codecsTemplate, m)
+	return sw.Error()
+}
+
+var codecsTemplate = `
+// Scheme holds the types of all the versions of the API group.
+var Scheme = $.newScheme|raw$()
+
+// Codecs provides the serializers of the types of Scheme.
+var Codecs = $.newCodecFactory|raw$(Scheme)
+
+// ParameterCodec converts query parameters to and from the types of Scheme.
+var ParameterCodec = $.newParameterCodec|raw$(Scheme)
+
+func init() {
+	Install(Scheme)
+}
+
+// Encoder returns an encoder to the given media type, e.g. application/json,
+// which converts the objects it encodes to the given version.
+func Encoder(mediaType string, version $.groupVersioner|raw$) ($.encoder|raw$, error) {
+	info, ok := $.serializerInfoForMediaType|raw$(Codecs.SupportedMediaTypes(), mediaType)
+	if !ok {
+		return nil, $.errorf|raw$("unsupported media type %q", mediaType)
+	}
+	return Codecs.EncoderForVersion(info.Serializer, version), nil
+}
+
+$if .internal$
+// Decoder returns a decoder of any supported media type, which converts the
+// objects it decodes to the given versions, or to the internal version if none
+// is given.
+func Decoder(versions ...$.groupVersion|raw$) $.decoder|raw$ {
+	return Codecs.UniversalDecoder(versions...)
+}
+$else$
+// Decoder returns a decoder of any supported media type, which converts the
+// objects it decodes to the given versions, or leaves them in their version if
+// none is given.
+func Decoder(versions ...$.groupVersion|raw$) $.decoder|raw$ {
+	if len(versions) == 0 {
+		versions = Scheme.PrioritizedVersionsAllGroups()
+	}
+	return Codecs.UniversalDecoder(versions...)
+}
+$end$
+`
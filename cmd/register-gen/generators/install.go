@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"io"
+	"path"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+)
+
+// installGenerator produces the install package of an API group, which
+// registers the internal version and all the external versions of the group
+// into a scheme, in priority order.
+type installGenerator struct {
+	generator.GoGenerator
+	outputPackage string
+	// internal is the package of the internal version, if any.
+	internal string
+	// versions are the packages of the external versions.
+	versions []string
+	// otherGroups are the prefixes of the other groups hosted by the
+	// packages of the versions, by package.
+	otherGroups map[string][]string
+	imports     namer.ImportTracker
+}
+
+var _ generator.Generator = &installGenerator{}
+
+func (g *installGenerator) Filter(_ *generator.Context, _ *types.Type) bool {
+	return false
+}
+
+func (g *installGenerator) Imports(c *generator.Context) (imports []string) {
+	return g.imports.ImportLines()
+}
+
+func (g *installGenerator) Namers(_ *generator.Context) namer.NameSystems {
+	return namer.NameSystems{
+		"raw": namer.NewRawNamer(g.outputPackage, g.imports),
+	}
+}
+
+func (g *installGenerator) Init(context *generator.Context, w io.Writer) error {
+	// the versions are registered by decreasing priority, e.g. v1, v1beta1, v1alpha1
+	versions := append([]string(nil), g.versions...)
+	sort.Slice(versions, func(i, j int) bool {
+		return version.CompareKubeAwareVersionStrings(path.Base(versions[i]), path.Base(versions[j])) > 0
+	})
+
+	addToScheme := []*types.Type{}
+	if len(g.internal) > 0 {
+		addToScheme = append(addToScheme, types.Ref(g.internal, "AddToScheme"))
+	}
+	groupVersions := []*types.Type{}
+	otherGroupVersions := map[string][]*types.Type{}
+	for _, v := range versions {
+		addToScheme = append(addToScheme, types.Ref(v, "AddToScheme"))
+		groupVersions = append(groupVersions, types.Ref(v, "SchemeGroupVersion"))
+		for _, prefix := range g.otherGroups[v] {
+			addToScheme = append(addToScheme, types.Ref(v, "Add"+prefix+"ToScheme"))
+			otherGroupVersions[prefix] = append(otherGroupVersions[prefix], types.Ref(v, prefix+"SchemeGroupVersion"))
+		}
+	}
+	// the versions of each group are prioritized separately
+	priorities := [][]*types.Type{groupVersions}
+	prefixes := []string{}
+	for prefix := range otherGroupVersions {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		priorities = append(priorities, otherGroupVersions[prefix])
+	}
+
+	sw := generator.NewSnippetWriter(w, context, "$", "$")
+	args := generator.Args{
+		"scheme": types.Ref("k8s.io/apimachinery/pkg/runtime", "Scheme"),
+		"must":   types.Ref("k8s.io/apimachinery/pkg/util/runtime", "Must"),
+	}
+	sw.Do("// Install registers the API group and adds its types to a scheme.\n", nil)
+	sw.Do("func Install(scheme *$.scheme|raw$) {\n", args)
+	for _, f := range addToScheme {
+		sw.Do("$.must|raw$($.addToScheme|raw$(scheme))\n", args.With("addToScheme", f))
+	}
+	for _, gvs := range priorities {
+		sw.Do("$.must|raw$(scheme.SetVersionPriority(", args)
+		for _, gv := range gvs {
+			sw.Do("$.|raw$, ", gv)
+		}
+		sw.Do("))\n", nil)
+	}
+	sw.Do("}\n", nil)
+	return sw.Error()
+}
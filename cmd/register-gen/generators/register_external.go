@@ -19,8 +19,11 @@ package generators
 import (
 	"io"
 	"sort"
+	"strings"
 
+	clientgenutil "k8s.io/code-generator/cmd/client-gen/generators/util"
 	clientgentypes "k8s.io/code-generator/cmd/client-gen/types"
+	codegennamer "k8s.io/code-generator/pkg/namer"
 	"k8s.io/gengo/v2/generator"
 	"k8s.io/gengo/v2/namer"
 	"k8s.io/gengo/v2/types"
@@ -32,6 +35,33 @@ type registerExternalGenerator struct {
 	gv              clientgentypes.GroupVersion
 	typesToGenerate []*types.Type
 	imports         namer.ImportTracker
+	// generatedFuncs are the names of the functions generated by other
+	// generators for the package, e.g. RegisterDefaults, which are registered
+	// with the SchemeBuilder.
+	generatedFuncs []string
+	// selfRegisteringFuncs are the names of the functions generated by other
+	// generators for the package which register themselves with the
+	// SchemeBuilder, e.g. RegisterConversions.
+	selfRegisteringFuncs []string
+	// otherGroups are the groups, other than the group of the package, of
+	// the types tagged with their own group name.
+	otherGroups []*typeGroup
+	// kindHelpers enables the Kind functions and the GroupVersionKind and
+	// GroupVersionResource variables of the registered types.
+	kindHelpers bool
+	// pluralExceptions name the resources of the types which are not
+	// pluralized regularly.
+	pluralExceptions map[string]string
+}
+
+// typeGroup holds the types of a package registered into a group other than
+// the group of the package.
+type typeGroup struct {
+	name string
+	// prefix is the prefix of the identifiers of the group, e.g. Foo for
+	// foo.example.com.
+	prefix string
+	types  []*types.Type
 }
 
 var _ generator.Generator = &registerExternalGenerator{}
@@ -51,26 +81,76 @@ func (g *registerExternalGenerator) Namers(_ *generator.Context) namer.NameSyste
 }
 
 func (g *registerExternalGenerator) Finalize(context *generator.Context, w io.Writer) error {
-	typesToGenerateOnlyNames := make([]string, len(g.typesToGenerate))
-	for index, typeToGenerate := range g.typesToGenerate {
-		typesToGenerateOnlyNames[index] = typeToGenerate.Name.Name
-	}
-
-	// sort the list of types to register, so that the generator produces stable output
-	sort.Strings(typesToGenerateOnlyNames)
-
 	sw := generator.NewSnippetWriter(w, context, "$", "$")
 	m := map[string]interface{}{
-		"groupName":         g.gv.Group,
-		"version":           g.gv.Version,
-		"types":             typesToGenerateOnlyNames,
-		"addToGroupVersion": context.Universe.Function(types.Name{Package: "k8s.io/apimachinery/pkg/apis/meta/v1", Name: "AddToGroupVersion"}),
-		"groupVersion":      context.Universe.Type(types.Name{Package: "k8s.io/apimachinery/pkg/apis/meta/v1", Name: "GroupVersion"}),
+		"groupName":            g.gv.Group,
+		"version":              g.gv.Version,
+		"types":                sortedTypeNames(g.typesToGenerate),
+		"kindHelpers":          g.kindHelpers,
+		"kinds":                g.kinds("", g.typesToGenerate),
+		"generatedFuncs":       g.generatedFuncs,
+		"selfRegisteringFuncs": strings.Join(g.selfRegisteringFuncs, ", "),
+		"addToGroupVersion":    context.Universe.Function(types.Name{Package: "k8s.io/apimachinery/pkg/apis/meta/v1", Name: "AddToGroupVersion"}),
+		"groupVersion":         context.Universe.Type(types.Name{Package: "k8s.io/apimachinery/pkg/apis/meta/v1", Name: "GroupVersion"}),
+		"schemaGroupVersion":   types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupVersion"),
+		"groupResource":        types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupResource"),
+		"groupKind":            types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupKind"),
+		"schemeBuilder":        types.Ref("k8s.io/apimachinery/pkg/runtime", "SchemeBuilder"),
+		"scheme":               types.Ref("k8s.io/apimachinery/pkg/runtime", "Scheme"),
+		"newSchemeBuilder":     types.Ref("k8s.io/apimachinery/pkg/runtime", "NewSchemeBuilder"),
 	}
 	sw.Do(registerExternalTypesTemplate, m)
+	for _, group := range g.otherGroups {
+		m["groupName"] = group.name
+		m["prefix"] = group.prefix
+		m["types"] = sortedTypeNames(group.types)
+		m["kinds"] = g.kinds(group.prefix, group.types)
+		sw.Do(registerOtherGroupTemplate, m)
+	}
 	return sw.Error()
 }
 
+// kind describes a registered type, for its GroupVersionKind and, if it is a
+// resource, its GroupVersionResource.
+type kind struct {
+	Name string
+	// GroupVersion is the name of the variable holding the group version of
+	// the type.
+	GroupVersion string
+	// Resource is the name of the resource of the type if it has a client,
+	// i.e. is tagged with +genclient, empty otherwise.
+	Resource string
+}
+
+// kinds returns the kinds of the given types of the group with the given
+// prefix, sorted by name.
+func (g *registerExternalGenerator) kinds(prefix string, typesToRegister []*types.Type) []kind {
+	resourceNamer := codegennamer.NewTagOverrideNamer("resourceName", namer.NewAllLowercasePluralNamer(g.pluralExceptions))
+	kinds := []kind{}
+	for _, t := range typesToRegister {
+		k := kind{Name: t.Name.Name, GroupVersion: prefix + "SchemeGroupVersion"}
+		if clientgenutil.MustParseClientGenTags(append(t.SecondClosestCommentLines, t.CommentLines...)).GenerateClient {
+			k.Resource = resourceNamer.Name(t)
+		}
+		kinds = append(kinds, k)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].Name < kinds[j].Name
+	})
+	return kinds
+}
+
+// sortedTypeNames returns the names of the types to register, sorted so that
+// the generator produces stable output.
+func sortedTypeNames(typesToRegister []*types.Type) []string {
+	names := make([]string, len(typesToRegister))
+	for index, t := range typesToRegister {
+		names[index] = t.Name.Name
+	}
+	sort.Strings(names)
+	return names
+}
+
 var registerExternalTypesTemplate = `
 // GroupName specifies the group name used to register the objects.
 const GroupName = "$.groupName$"
@@ -80,31 +160,61 @@ var GroupVersion = $.groupVersion|raw${Group: GroupName, Version: "$.version$"}
 
 // SchemeGroupVersion is group version used to register these objects
 // Deprecated: use GroupVersion instead.
-var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "$.version$"}
+var SchemeGroupVersion = $.schemaGroupVersion|raw${Group: GroupName, Version: "$.version$"}
 
 // Resource takes an unqualified resource and returns a Group qualified GroupResource
-func Resource(resource string) schema.GroupResource {
+func Resource(resource string) $.groupResource|raw$ {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
+$if .kindHelpers$
+// Kind takes an unqualified kind and returns a Group qualified GroupKind
+func Kind(kind string) $.groupKind|raw$ {
+	return SchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
+var (
+$range .kinds -$
+	// $.Name$GroupVersionKind is the GroupVersionKind of $.Name$.
+	$.Name$GroupVersionKind = $.GroupVersion$.WithKind("$.Name$")
+$if .Resource -$
+	// $.Name$GroupVersionResource is the GroupVersionResource of $.Name$.
+	$.Name$GroupVersionResource = $.GroupVersion$.WithResource("$.Resource$")
+$end -$
+$end -$
+)
+$end$
 
 var (
 	// localSchemeBuilder and AddToScheme will stay in k8s.io/kubernetes.
-	SchemeBuilder      runtime.SchemeBuilder
+	SchemeBuilder      $.schemeBuilder|raw$
 	localSchemeBuilder = &SchemeBuilder
     // Deprecated: use Install instead
 	AddToScheme        = localSchemeBuilder.AddToScheme
 	Install            = localSchemeBuilder.AddToScheme
 )
 
+$if or .generatedFuncs .selfRegisteringFuncs$
+func init() {
+	// The functions generated for this package are registered here, so that
+	// AddToScheme never misses one of them.
+	localSchemeBuilder.Register(addKnownTypes$range .generatedFuncs$, $.$$end$)
+}
+$if .selfRegisteringFuncs$
+
+// These generated functions register themselves with localSchemeBuilder.
+var _ = []func(*$.scheme|raw$) error{$.selfRegisteringFuncs$}
+$end$
+$else$
 func init() {
 	// We only register manually written functions here. The registration of the
 	// generated functions takes place in the generated files. The separation
 	// makes the code compile even when the generated files are missing.
 	localSchemeBuilder.Register(addKnownTypes)
 }
+$end$
 
 // Adds the list of known types to Scheme.
-func addKnownTypes(scheme *runtime.Scheme) error {
+func addKnownTypes(scheme *$.scheme|raw$) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
     $range .types -$
         &$.${},
@@ -115,3 +225,49 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	return nil
 }
 `
+
+var registerOtherGroupTemplate = `
+// $.prefix$GroupName specifies the group name used to register the objects of the $.groupName$ group.
+const $.prefix$GroupName = "$.groupName$"
+
+// $.prefix$SchemeGroupVersion is group version used to register the objects of the $.groupName$ group.
+var $.prefix$SchemeGroupVersion = $.schemaGroupVersion|raw${Group: $.prefix$GroupName, Version: "$.version$"}
+
+// $.prefix$Resource takes an unqualified resource and returns a GroupResource qualified with the $.groupName$ group
+func $.prefix$Resource(resource string) $.groupResource|raw$ {
+	return $.prefix$SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+$if .kindHelpers$
+// $.prefix$Kind takes an unqualified kind and returns a GroupKind qualified with the $.groupName$ group
+func $.prefix$Kind(kind string) $.groupKind|raw$ {
+	return $.prefix$SchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
+var (
+$range .kinds -$
+	// $.Name$GroupVersionKind is the GroupVersionKind of $.Name$.
+	$.Name$GroupVersionKind = $.GroupVersion$.WithKind("$.Name$")
+$if .Resource -$
+	// $.Name$GroupVersionResource is the GroupVersionResource of $.Name$.
+	$.Name$GroupVersionResource = $.GroupVersion$.WithResource("$.Resource$")
+$end -$
+$end -$
+)
+$end$
+
+var (
+	$.prefix$SchemeBuilder = $.newSchemeBuilder|raw$(add$.prefix$KnownTypes)
+	Add$.prefix$ToScheme   = $.prefix$SchemeBuilder.AddToScheme
+)
+
+// Adds the list of known types of the $.groupName$ group to Scheme.
+func add$.prefix$KnownTypes(scheme *$.scheme|raw$) error {
+	scheme.AddKnownTypes($.prefix$SchemeGroupVersion,
+    $range .types -$
+        &$.${},
+    $end$
+	)
+	$.addToGroupVersion|raw$(scheme, $.prefix$SchemeGroupVersion)
+	return nil
+}
+`
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"io"
+
+	"k8s.io/gengo/v2/generator"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+)
+
+// registerInternalGenerator produces the registration of the types of an
+// internal package into the internal version of its group, the hub the
+// external versions are converted through.
+type registerInternalGenerator struct {
+	generator.GoGenerator
+	outputPackage   string
+	groupName       string
+	typesToGenerate []*types.Type
+	imports         namer.ImportTracker
+}
+
+var _ generator.Generator = &registerInternalGenerator{}
+
+func (g *registerInternalGenerator) Filter(_ *generator.Context, _ *types.Type) bool {
+	return false
+}
+
+func (g *registerInternalGenerator) Imports(c *generator.Context) (imports []string) {
+	return g.imports.ImportLines()
+}
+
+func (g *registerInternalGenerator) Namers(_ *generator.Context) namer.NameSystems {
+	return namer.NameSystems{
+		"raw": namer.NewRawNamer(g.outputPackage, g.imports),
+	}
+}
+
+func (g *registerInternalGenerator) Finalize(context *generator.Context, w io.Writer) error {
+	sw := generator.NewSnippetWriter(w, context, "$", "$")
+	m := map[string]interface{}{
+		"groupName":          g.groupName,
+		"types":              sortedTypeNames(g.typesToGenerate),
+		"apiVersionInternal": types.Ref("k8s.io/apimachinery/pkg/runtime", "APIVersionInternal"),
+		"schemaGroupVersion": types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupVersion"),
+		"groupResource":      types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupResource"),
+		"groupKind":          types.Ref("k8s.io/apimachinery/pkg/runtime/schema", "GroupKind"),
+		"newSchemeBuilder":   types.Ref("k8s.io/apimachinery/pkg/runtime", "NewSchemeBuilder"),
+		"scheme":             types.Ref("k8s.io/apimachinery/pkg/runtime", "Scheme"),
+	}
+	sw.Do(registerInternalTypesTemplate, m)
+	return sw.Error()
+}
+
+var registerInternalTypesTemplate = `
+// GroupName specifies the group name used to register the objects.
+const GroupName = "$.groupName$"
+
+// SchemeGroupVersion is the internal version of the group, used to register these objects
+var SchemeGroupVersion = $.schemaGroupVersion|raw${Group: GroupName, Version: $.apiVersionInternal|raw$}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource
+func Resource(resource string) $.groupResource|raw$ {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+// Kind takes an unqualified kind and returns a Group qualified GroupKind
+func Kind(kind string) $.groupKind|raw$ {
+	return SchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
+var (
+	SchemeBuilder = $.newSchemeBuilder|raw$(addKnownTypes)
+	AddToScheme   = SchemeBuilder.AddToScheme
+)
+
+// Adds the list of known types to Scheme.
+func addKnownTypes(scheme *$.scheme|raw$) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+    $range .types -$
+        &$.${},
+    $end$
+	)
+	return nil
+}
+`
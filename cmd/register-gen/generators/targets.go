@@ -20,12 +20,15 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"k8s.io/klog/v2"
 
 	clientgentypes "k8s.io/code-generator/cmd/client-gen/types"
 	"k8s.io/code-generator/cmd/register-gen/args"
+	"k8s.io/code-generator/pkg/util"
 	"k8s.io/gengo/v2"
 	"k8s.io/gengo/v2/generator"
 	"k8s.io/gengo/v2/namer"
@@ -50,7 +53,10 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 		klog.Fatalf("Failed loading boilerplate: %v", err)
 	}
 
+	pluralExceptions := util.PluralExceptionListToMapOrDie(args.PluralExceptions)
+
 	targets := []generator.Target{}
+	groups := map[string]*installGroup{}
 	for _, input := range context.Inputs {
 		pkg := context.Universe.Package(input)
 		internal, err := isInternal(pkg)
@@ -58,18 +64,32 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 			klog.V(5).Infof("skipping the generation of %s file, due to err %v", args.OutputFile, err)
 			continue
 		}
-		if internal {
-			klog.V(5).Infof("skipping the generation of %s file because %s package contains internal types, note that internal types don't have \"json\" tags", args.OutputFile, pkg.Name)
-			continue
-		}
 		registerFileName := "register.go"
 		searchPath := path.Join(pkg.Dir, registerFileName)
+		handWritten := false
 		if _, err := os.Stat(path.Join(searchPath)); err == nil {
-			klog.V(5).Infof("skipping the generation of %s file because %s already exists in the path %s", args.OutputFile, registerFileName, searchPath)
-			continue
+			handWritten = true
 		} else if err != nil && !os.IsNotExist(err) {
 			klog.Fatalf("an error %v has occurred while checking if %s exists", err, registerFileName)
 		}
+		var installGroup *installGroup
+		if len(args.InstallOutputFile) > 0 {
+			generated := !handWritten && (!internal || args.RegisterInternal)
+			installGroup = addToInstallGroup(groups, pkg, internal, generated)
+		}
+		if internal && !args.RegisterInternal {
+			klog.V(5).Infof("skipping the generation of %s file because %s package contains internal types, note that internal types don't have \"json\" tags", args.OutputFile, pkg.Name)
+			continue
+		}
+		if handWritten {
+			klog.V(5).Infof("skipping the generation of %s file because %s already exists in the path %s", args.OutputFile, registerFileName, searchPath)
+			continue
+		}
+
+		if internal {
+			targets = append(targets, internalTarget(pkg, args, boilerplate))
+			continue
+		}
 
 		gv := clientgentypes.GroupVersion{}
 		{
@@ -90,15 +110,52 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 			}
 		}
 
+		var generatedFuncs, selfRegisteringFuncs []string
+		if args.RegisterGeneratedFuncs {
+			generatedFuncs, selfRegisteringFuncs = generatedRegisterFuncs(pkg)
+		}
+
 		typesToRegister := []*types.Type{}
+		typeGroups := map[string]*typeGroup{}
 		for _, t := range pkg.Types {
 			klog.V(5).Infof("considering type = %s", t.Name.String())
 			for _, typeMember := range t.Members {
 				if typeMember.Name == "TypeMeta" && typeMember.Embedded {
+					// if there is a comment of the form "// +groupName=othergroup" on the type,
+					// register it into that group instead of the group of the package
+					if override := gengo.ExtractCommentTags("+", append(t.SecondClosestCommentLines, t.CommentLines...))["groupName"]; override != nil && override[0] != string(gv.Group) {
+						groupName := override[0]
+						klog.V(5).Infof("registering type %s into group %s", t.Name.String(), groupName)
+						if typeGroups[groupName] == nil {
+							typeGroups[groupName] = &typeGroup{name: groupName, prefix: groupPrefix(groupName)}
+						}
+						typeGroups[groupName].types = append(typeGroups[groupName].types, t)
+						continue
+					}
 					typesToRegister = append(typesToRegister, t)
 				}
 			}
 		}
+		otherGroups := sortedTypeGroups(typeGroups)
+		if args.Strict {
+			registered := map[*types.Type]bool{}
+			for _, t := range typesToRegister {
+				registered[t] = true
+			}
+			for _, group := range otherGroups {
+				for _, t := range group.types {
+					registered[t] = true
+				}
+			}
+			if problems := unregisteredTypes(pkg, registered); len(problems) > 0 {
+				klog.Fatalf("incomplete registration of the types of package %s:\n%s", pkg.Path, strings.Join(problems, "\n"))
+			}
+		}
+		if installGroup != nil {
+			for _, group := range otherGroups {
+				installGroup.otherGroups[pkg.Path] = append(installGroup.otherGroups[pkg.Path], group.prefix)
+			}
+		}
 
 		targets = append(targets,
 			&generator.SimpleTarget{
@@ -112,16 +169,233 @@ func GetTargets(context *generator.Context, args *args.Args) []generator.Target
 							GoGenerator: generator.GoGenerator{
 								OutputFilename: args.OutputFile,
 							},
-							gv:              gv,
-							typesToGenerate: typesToRegister,
-							outputPackage:   pkg.Path,
-							imports:         generator.NewImportTrackerForPackage(pkg.Path),
+							gv:                   gv,
+							typesToGenerate:      typesToRegister,
+							outputPackage:        pkg.Path,
+							imports:              generator.NewImportTrackerForPackage(pkg.Path),
+							generatedFuncs:       generatedFuncs,
+							selfRegisteringFuncs: selfRegisteringFuncs,
+							otherGroups:          otherGroups,
+							kindHelpers:          args.KindHelpers,
+							pluralExceptions:     pluralExceptions,
 						},
 					}
 				},
 			})
 	}
 
+	if len(args.InstallOutputFile) > 0 {
+		targets = append(targets, installTargets(groups, args, boilerplate)...)
+	}
+
+	return targets
+}
+
+// internalTarget returns the target of the registration of the internal
+// version of a group, whose package is named after the group.
+func internalTarget(pkg *types.Package, args *args.Args, boilerplate []byte) generator.Target {
+	groupName := path.Base(pkg.Path)
+	if override := gengo.ExtractCommentTags("+", pkg.Comments)["groupName"]; override != nil {
+		groupName = override[0]
+		klog.V(5).Infof("overriding the group name with = %s", groupName)
+	}
+
+	typesToRegister := []*types.Type{}
+	registered := map[*types.Type]bool{}
+	for _, t := range pkg.Types {
+		for _, typeMember := range t.Members {
+			if typeMember.Name == "TypeMeta" && typeMember.Embedded {
+				typesToRegister = append(typesToRegister, t)
+				registered[t] = true
+			}
+		}
+	}
+	if args.Strict {
+		if problems := unregisteredTypes(pkg, registered); len(problems) > 0 {
+			klog.Fatalf("incomplete registration of the types of package %s:\n%s", pkg.Path, strings.Join(problems, "\n"))
+		}
+	}
+
+	return &generator.SimpleTarget{
+		PkgName:       pkg.Name,
+		PkgPath:       pkg.Path, // output to same pkg as input
+		PkgDir:        pkg.Dir,  // output to same pkg as input
+		HeaderComment: boilerplate,
+		GeneratorsFunc: func(c *generator.Context) (generators []generator.Generator) {
+			return []generator.Generator{
+				&registerInternalGenerator{
+					GoGenerator: generator.GoGenerator{
+						OutputFilename: args.OutputFile,
+					},
+					groupName:       groupName,
+					typesToGenerate: typesToRegister,
+					outputPackage:   pkg.Path,
+					imports:         generator.NewImportTrackerForPackage(pkg.Path),
+				},
+			}
+		},
+	}
+}
+
+// generatedRegisterFuncs returns the registration functions generated for pkg
+// by the generators it is tagged for, split between those which must be
+// registered with the SchemeBuilder, and those which register themselves.
+// conversion-gen registers its functions in the init function of the
+// generated files, defaulter-gen does not.
+func generatedRegisterFuncs(pkg *types.Package) (generated, selfRegistering []string) {
+	tags := gengo.ExtractCommentTags("+", pkg.Comments)
+	tagged := func(name string) bool {
+		values := tags[name]
+		return len(values) > 0 && values[0] != "false"
+	}
+	if tagged("k8s:conversion-gen") {
+		selfRegistering = append(selfRegistering, "RegisterConversions")
+	}
+	if tagged("k8s:defaulter-gen") {
+		generated = append(generated, "RegisterDefaults")
+	}
+	return generated, selfRegistering
+}
+
+// groupPrefix returns the prefix of the identifiers of a group in the
+// packages hosting types of several groups, e.g. "FooBar" for
+// "foo-bar.example.com".
+func groupPrefix(groupName string) string {
+	prefix := ""
+	for _, word := range strings.Split(strings.SplitN(groupName, ".", 2)[0], "-") {
+		prefix += namer.IC(word)
+	}
+	return prefix
+}
+
+// sortedTypeGroups returns the groups sorted by name, and fails if two of them
+// have the same prefix.
+func sortedTypeGroups(typeGroups map[string]*typeGroup) []*typeGroup {
+	sorted := []*typeGroup{}
+	for _, group := range typeGroups {
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].name < sorted[j].name
+	})
+	prefixes := map[string]string{}
+	for _, group := range sorted {
+		if other, ok := prefixes[group.prefix]; ok {
+			klog.Fatalf("the groups %s and %s cannot be hosted by the same package, their identifiers would be prefixed with %s", other, group.name, group.prefix)
+		}
+		prefixes[group.prefix] = group.name
+	}
+	return sorted
+}
+
+// installGroup holds the packages of the versions of an API group, which are
+// registered by its install package.
+type installGroup struct {
+	path     string
+	dir      string
+	internal string
+	versions []string
+	// otherGroups are the prefixes of the other groups hosted by the
+	// packages of the versions, by package.
+	otherGroups map[string][]string
+}
+
+// addToInstallGroup adds pkg to the group it is a version of: the group of an
+// internal package is the package itself, the group of an external package
+// is its parent. The install package calls the AddToScheme function of the
+// version, so it must be generated, or declared by hand: internal versions
+// without it are not installed, external versions without it are an error.
+func addToInstallGroup(groups map[string]*installGroup, pkg *types.Package, internal, generated bool) *installGroup {
+	groupPath, groupDir := path.Dir(pkg.Path), filepath.Dir(pkg.Dir)
+	if internal {
+		groupPath, groupDir = pkg.Path, pkg.Dir
+	}
+	group, ok := groups[groupPath]
+	if !ok {
+		group = &installGroup{path: groupPath, dir: groupDir, otherGroups: map[string][]string{}}
+		groups[groupPath] = group
+	}
+	// Install registers the external versions with their SchemeGroupVersion.
+	required := []string{"AddToScheme", "SchemeGroupVersion"}
+	if internal {
+		required = required[:1]
+	}
+	if !generated {
+		for _, name := range required {
+			if declares(pkg, name) {
+				continue
+			}
+			if internal {
+				klog.V(2).Infof("not installing the internal version %s, which declares no %s; generate it with --register-internal", pkg.Path, name)
+				return group
+			}
+			klog.Fatalf("the install package of %s cannot install %s, which declares no %s", groupPath, pkg.Path, name)
+		}
+	}
+	if internal {
+		group.internal = pkg.Path
+	} else {
+		group.versions = append(group.versions, pkg.Path)
+	}
+	return group
+}
+
+// declares returns whether pkg declares a function or variable with the given
+// name.
+func declares(pkg *types.Package, name string) bool {
+	return pkg.Functions[name] != nil || pkg.Variables[name] != nil
+}
+
+// installTargets returns the targets of the install packages of the groups
+// with at least one external version.
+func installTargets(groups map[string]*installGroup, args *args.Args, boilerplate []byte) []generator.Target {
+	groupPaths := []string{}
+	for groupPath := range groups {
+		groupPaths = append(groupPaths, groupPath)
+	}
+	sort.Strings(groupPaths)
+
+	targets := []generator.Target{}
+	for _, groupPath := range groupPaths {
+		group := groups[groupPath]
+		if len(group.versions) == 0 {
+			klog.V(5).Infof("skipping the generation of the install package of %s, which has no external version", groupPath)
+			continue
+		}
+		installPath := path.Join(group.path, "install")
+		targets = append(targets,
+			&generator.SimpleTarget{
+				PkgName:       "install",
+				PkgPath:       installPath,
+				PkgDir:        filepath.Join(group.dir, "install"),
+				HeaderComment: boilerplate,
+				GeneratorsFunc: func(c *generator.Context) (generators []generator.Generator) {
+					generators = []generator.Generator{
+						&installGenerator{
+							GoGenerator: generator.GoGenerator{
+								OutputFilename: args.InstallOutputFile,
+							},
+							outputPackage: installPath,
+							internal:      group.internal,
+							versions:      group.versions,
+							otherGroups:   group.otherGroups,
+							imports:       generator.NewImportTrackerForPackage(installPath),
+						},
+					}
+					if len(args.CodecsOutputFile) > 0 {
+						generators = append(generators, &codecsGenerator{
+							GoGenerator: generator.GoGenerator{
+								OutputFilename: args.CodecsOutputFile,
+							},
+							outputPackage: installPath,
+							internal:      len(group.internal) > 0,
+							imports:       generator.NewImportTrackerForPackage(installPath),
+						})
+					}
+					return generators
+				},
+			})
+	}
 	return targets
 }
 
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestGroupPrefix(t *testing.T) {
+	for groupName, expected := range map[string]string{
+		"gadgets.example.com":  "Gadgets",
+		"foo-bar.example.com":  "FooBar",
+		"apps":                 "Apps",
+		"a-b-c.k8s.io":         "ABC",
+		"batch.tutorial.io.io": "Batch",
+	} {
+		if prefix := groupPrefix(groupName); prefix != expected {
+			t.Errorf("groupPrefix(%q) = %q, expected %q", groupName, prefix, expected)
+		}
+	}
+}
+
+func TestSortedTypeGroups(t *testing.T) {
+	typeGroups := map[string]*typeGroup{}
+	for _, name := range []string{"things.example.com", "gadgets.example.com", "foo-bar.example.com"} {
+		typeGroups[name] = &typeGroup{name: name, prefix: groupPrefix(name)}
+	}
+	names := []string{}
+	for _, group := range sortedTypeGroups(typeGroups) {
+		names = append(names, group.name)
+	}
+	expected := []string{"foo-bar.example.com", "gadgets.example.com", "things.example.com"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("got the groups %v, expected %v", names, expected)
+	}
+	if sorted := sortedTypeGroups(map[string]*typeGroup{}); len(sorted) != 0 {
+		t.Errorf("got the groups %v without type groups", sorted)
+	}
+}
+
+func TestGeneratedRegisterFuncs(t *testing.T) {
+	tests := []struct {
+		name                    string
+		comments                []string
+		generated, selfRegister []string
+	}{{
+		name: "untagged",
+	}, {
+		name:      "defaulter-gen",
+		comments:  []string{"+k8s:defaulter-gen=TypeMeta"},
+		generated: []string{"RegisterDefaults"},
+	}, {
+		name:         "conversion-gen",
+		comments:     []string{"+k8s:conversion-gen=k8s.io/example/apis/widgets"},
+		selfRegister: []string{"RegisterConversions"},
+	}, {
+		name:         "all",
+		comments:     []string{"+k8s:conversion-gen=k8s.io/example/apis/widgets", "+k8s:defaulter-gen=TypeMeta", "+groupName=widgets.example.com"},
+		generated:    []string{"RegisterDefaults"},
+		selfRegister: []string{"RegisterConversions"},
+	}, {
+		name:     "disabled",
+		comments: []string{"+k8s:conversion-gen=false", "+k8s:defaulter-gen=false"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			generated, selfRegistering := generatedRegisterFuncs(&types.Package{Comments: tc.comments})
+			if !reflect.DeepEqual(generated, tc.generated) {
+				t.Errorf("got the generated functions %v, expected %v", generated, tc.generated)
+			}
+			if !reflect.DeepEqual(selfRegistering, tc.selfRegister) {
+				t.Errorf("got the self-registering functions %v, expected %v", selfRegistering, tc.selfRegister)
+			}
+		})
+	}
+}
+
+func TestAddToInstallGroup(t *testing.T) {
+	internal := &types.Package{Path: "example.com/apis/widgets", Dir: "/src/apis/widgets"}
+	v1 := &types.Package{Path: "example.com/apis/widgets/v1", Dir: "/src/apis/widgets/v1"}
+	handWritten := &types.Package{
+		Path:      "example.com/apis/widgets/v1beta1",
+		Dir:       "/src/apis/widgets/v1beta1",
+		Variables: map[string]*types.Type{"AddToScheme": {}, "SchemeGroupVersion": {}},
+	}
+
+	groups := map[string]*installGroup{}
+	addToInstallGroup(groups, internal, true, false)
+	addToInstallGroup(groups, v1, false, true)
+	group := addToInstallGroup(groups, handWritten, false, false)
+	if len(groups) != 1 || groups["example.com/apis/widgets"] != group {
+		t.Fatalf("got the groups %v, expected the widgets group only", groups)
+	}
+	if group.dir != "/src/apis/widgets" {
+		t.Errorf("got the directory %s, expected the directory of the internal package", group.dir)
+	}
+	// The internal version declares no AddToScheme, nor gets one generated.
+	if group.internal != "" {
+		t.Errorf("got the internal version %s, expected none", group.internal)
+	}
+	if expected := []string{v1.Path, handWritten.Path}; !reflect.DeepEqual(group.versions, expected) {
+		t.Errorf("got the versions %v, expected %v", group.versions, expected)
+	}
+
+	addToInstallGroup(groups, internal, true, true)
+	if group.internal != internal.Path {
+		t.Errorf("got the internal version %q, expected %s", group.internal, internal.Path)
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/gengo/v2"
+	"k8s.io/gengo/v2/namer"
+	"k8s.io/gengo/v2/types"
+
+	clientgenutil "k8s.io/code-generator/cmd/client-gen/generators/util"
+)
+
+const runtimeObjectInterface = "k8s.io/apimachinery/pkg/runtime.Object"
+
+// unregisteredTypes returns the problems of the registration of the types of
+// pkg, given the registered ones: the exported types implementing
+// runtime.Object which are not registered, and the types with clients whose
+// List type is not registered.
+func unregisteredTypes(pkg *types.Package, registered map[*types.Type]bool) []string {
+	problems := []string{}
+	for _, t := range pkg.Types {
+		if registered[t] || namer.IsPrivateGoName(t.Name.Name) {
+			continue
+		}
+		if isRuntimeObject(t) {
+			problems = append(problems, fmt.Sprintf("type %s implements runtime.Object but is not registered, it must embed TypeMeta", t.Name.Name))
+		}
+	}
+	for t := range registered {
+		if !clientgenutil.MustParseClientGenTags(append(t.SecondClosestCommentLines, t.CommentLines...)).GenerateClient {
+			continue
+		}
+		list := pkg.Types[t.Name.Name+"List"]
+		switch {
+		case list == nil:
+			problems = append(problems, fmt.Sprintf("type %s has a client but there is no %sList type", t.Name.Name, t.Name.Name))
+		case !registered[list] && !isRuntimeObject(list):
+			// lists implementing runtime.Object are reported above
+			problems = append(problems, fmt.Sprintf("type %sList is not registered, it must embed TypeMeta", t.Name.Name))
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// isRuntimeObject returns whether t implements runtime.Object, with a
+// DeepCopyObject method or one generated by deepcopy-gen.
+func isRuntimeObject(t *types.Type) bool {
+	if _, ok := t.Methods["DeepCopyObject"]; ok {
+		return true
+	}
+	for _, v := range gengo.ExtractCommentTags("+", append(t.SecondClosestCommentLines, t.CommentLines...))["k8s:deepcopy-gen:interfaces"] {
+		for _, iface := range strings.Split(v, ",") {
+			if iface == runtimeObjectInterface {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/gengo/v2/types"
+)
+
+func TestUnregisteredTypes(t *testing.T) {
+	object := func(name string, comments ...string) *types.Type {
+		return &types.Type{
+			Name:         types.Name{Package: "example.com/apis/widgets/v1", Name: name},
+			Kind:         types.Struct,
+			CommentLines: comments,
+			Methods:      map[string]*types.Type{"DeepCopyObject": {}},
+		}
+	}
+	tagged := func(name string, comments ...string) *types.Type {
+		t := object(name, append(comments, "+k8s:deepcopy-gen:interfaces="+runtimeObjectInterface)...)
+		t.Methods = nil
+		return t
+	}
+	plain := func(name string) *types.Type {
+		return &types.Type{Name: types.Name{Package: "example.com/apis/widgets/v1", Name: name}, Kind: types.Struct}
+	}
+
+	tests := []struct {
+		name       string
+		types      []*types.Type
+		registered []string
+		expected   []string
+	}{{
+		name:       "complete",
+		types:      []*types.Type{object("Widget", "+genclient"), object("WidgetList"), plain("WidgetSpec")},
+		registered: []string{"Widget", "WidgetList"},
+		expected:   []string{},
+	}, {
+		name:       "unregistered object",
+		types:      []*types.Type{object("Widget"), tagged("Gadget"), object("privateObject")},
+		registered: []string{"Widget"},
+		expected:   []string{"type Gadget implements runtime.Object but is not registered, it must embed TypeMeta"},
+	}, {
+		name:       "unregistered list",
+		types:      []*types.Type{object("Widget", "+genclient"), object("WidgetList")},
+		registered: []string{"Widget"},
+		expected:   []string{"type WidgetList implements runtime.Object but is not registered, it must embed TypeMeta"},
+	}, {
+		name:       "list without runtime.Object",
+		types:      []*types.Type{object("Widget", "+genclient"), plain("WidgetList")},
+		registered: []string{"Widget"},
+		expected:   []string{"type WidgetList is not registered, it must embed TypeMeta"},
+	}, {
+		name:       "missing list",
+		types:      []*types.Type{object("Widget", "+genclient"), object("Gadget")},
+		registered: []string{"Widget", "Gadget"},
+		expected:   []string{"type Widget has a client but there is no WidgetList type"},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := &types.Package{Path: "example.com/apis/widgets/v1", Types: map[string]*types.Type{}}
+			for _, typ := range tc.types {
+				pkg.Types[typ.Name.Name] = typ
+			}
+			registered := map[*types.Type]bool{}
+			for _, name := range tc.registered {
+				registered[pkg.Types[name]] = true
+			}
+			if problems := unregisteredTypes(pkg, registered); !reflect.DeepEqual(problems, tc.expected) {
+				t.Errorf("got the problems %q, expected %q", problems, tc.expected)
+			}
+		})
+	}
+}
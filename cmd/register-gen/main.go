@@ -14,6 +14,59 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
+// register-gen is a tool for auto-generating the registration of the types of
+// an API group version into a scheme.
+//
+// Given a list of input packages, it generates, for each external version
+// package without a register.go file, the group and version constants, the
+// SchemeBuilder and the registration of the types embedding TypeMeta. The
+// group and version are taken from the last two elements of the package path,
+// unless the group is overridden with a "+groupName" package comment.
+//
+// With --kind-helpers, besides the Resource function qualifying resources with
+// the group, a Kind function qualifies kinds with it, the GroupVersionKind of
+// each registered type is declared as <Type>GroupVersionKind and, for the types
+// with clients, i.e. tagged with "+genclient", the GroupVersionResource as
+// <Type>GroupVersionResource. The resources are named like by client-gen, with
+// --plural-exceptions and the "+resourceName" tag. These are opt-in since they
+// may collide with hand-written declarations of the API packages.
+//
+// With --strict, generation fails if an exported type implementing
+// runtime.Object, i.e. with a DeepCopyObject method or tagged to get one from
+// deepcopy-gen, is not registered because it does not embed TypeMeta, or if a
+// type tagged with "+genclient" has no registered List type, which would
+// otherwise only fail at runtime.
+//
+// Packages may host types of other groups, tagged with their own "+groupName"
+// comment. Each of these groups gets its own constants, SchemeBuilder and
+// AddToScheme function, whose names are prefixed with the first label of the
+// group name, e.g. FooBarSchemeGroupVersion and AddFooBarToScheme for the
+// foo-bar.example.com group.
+//
+// With --register-internal, internal packages, whose TypeMeta has no json
+// tags, are generated for too: their types are registered into the internal
+// version of the group named after the package, unless overridden with a
+// "+groupName" package comment. This is the hub the external versions are
+// converted through with the conversions generated by conversion-gen.
+//
+// With --install-output-file, the install package of each group, i.e. the
+// "install" subpackage of the internal version package, or of the parent of
+// the external version packages, is generated too. Its Install function
+// registers the internal version, if it is one of the input packages and
+// either declares an AddToScheme function or gets one generated with
+// --register-internal, and the external versions into a scheme, including the
+// other groups they host, and sets their priority, e.g. v1 over v1beta1.
+//
+// With --codecs-output-file, the install package also holds a Scheme the
+// group is installed into, its CodecFactory and ParameterCodec, and Encoder
+// and Decoder functions returning the encoders to given media types and
+// versions and the decoders of all supported media types.
+//
+// With --register-generated-funcs, the functions generated for the packages
+// tagged for defaulter-gen are registered with the SchemeBuilder too, so that
+// AddToScheme includes them. conversion-gen registers its functions itself,
+// which is checked at compile time instead: the package fails to build if the
+// tagged package misses its generated files.
 package main
 
 import (
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/code-generator/cmd/register-gen/output_tests/widgets"
+	v1 "k8s.io/code-generator/cmd/register-gen/output_tests/widgets/v1"
+	"k8s.io/code-generator/cmd/register-gen/output_tests/widgets/v1beta1"
+)
+
+func TestInstall(t *testing.T) {
+	scheme := runtime.NewScheme()
+	Install(scheme)
+
+	for gvk, expected := range map[schema.GroupVersionKind]runtime.Object{
+		{Group: "widgets.example.com", Version: runtime.APIVersionInternal, Kind: "Widget"}:     &widgets.Widget{},
+		{Group: "widgets.example.com", Version: runtime.APIVersionInternal, Kind: "WidgetList"}: &widgets.WidgetList{},
+		{Group: "widgets.example.com", Version: "v1", Kind: "Widget"}:                           &v1.Widget{},
+		{Group: "widgets.example.com", Version: "v1", Kind: "WidgetList"}:                       &v1.WidgetList{},
+		{Group: "widgets.example.com", Version: "v1beta1", Kind: "Widget"}:                      &v1beta1.Widget{},
+		{Group: "widgets.example.com", Version: "v1beta1", Kind: "WidgetList"}:                  &v1beta1.WidgetList{},
+		{Group: "gadgets.example.com", Version: "v1", Kind: "Gadget"}:                           &v1.Gadget{},
+		{Group: "gadgets.example.com", Version: "v1", Kind: "GadgetList"}:                       &v1.GadgetList{},
+	} {
+		obj, err := scheme.New(gvk)
+		if err != nil {
+			t.Errorf("%v is not registered: %v", gvk, err)
+			continue
+		}
+		if reflect.TypeOf(obj) != reflect.TypeOf(expected) {
+			t.Errorf("%v is registered as %T, expected %T", gvk, obj, expected)
+		}
+	}
+
+	// The types of the other group hosted by v1 are not registered into the
+	// group of the package.
+	if gvk := (schema.GroupVersionKind{Group: "widgets.example.com", Version: "v1", Kind: "Gadget"}); scheme.Recognizes(gvk) {
+		t.Errorf("%v is registered", gvk)
+	}
+
+	// The kind helpers generated with --kind-helpers match the registration.
+	for _, gvk := range []schema.GroupVersionKind{v1.WidgetGroupVersionKind, v1.WidgetListGroupVersionKind, v1.GadgetGroupVersionKind, v1.GadgetListGroupVersionKind} {
+		if !scheme.Recognizes(gvk) {
+			t.Errorf("%v is not registered", gvk)
+		}
+	}
+	if gvr := (schema.GroupVersionResource{Group: "widgets.example.com", Version: "v1", Resource: "widgets"}); v1.WidgetGroupVersionResource != gvr {
+		t.Errorf("got the resource %v, expected %v", v1.WidgetGroupVersionResource, gvr)
+	}
+
+	expected := []schema.GroupVersion{v1.SchemeGroupVersion, v1beta1.SchemeGroupVersion}
+	if versions := scheme.PrioritizedVersionsForGroup(v1.GroupName); !reflect.DeepEqual(versions, expected) {
+		t.Errorf("got the versions %v, expected %v", versions, expected)
+	}
+	expected = []schema.GroupVersion{v1.GadgetsSchemeGroupVersion}
+	if versions := scheme.PrioritizedVersionsForGroup(v1.GadgetsGroupName); !reflect.DeepEqual(versions, expected) {
+		t.Errorf("got the versions %v, expected %v", versions, expected)
+	}
+}
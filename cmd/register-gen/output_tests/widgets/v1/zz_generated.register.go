@@ -0,0 +1,124 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by register-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GroupName specifies the group name used to register the objects.
+const GroupName = "widgets.example.com"
+
+// GroupVersion specifies the group and the version used to register the objects.
+var GroupVersion = metav1.GroupVersion{Group: GroupName, Version: "v1"}
+
+// SchemeGroupVersion is group version used to register these objects
+// Deprecated: use GroupVersion instead.
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1"}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+// Kind takes an unqualified kind and returns a Group qualified GroupKind
+func Kind(kind string) schema.GroupKind {
+	return SchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
+var (
+	// WidgetGroupVersionKind is the GroupVersionKind of Widget.
+	WidgetGroupVersionKind = SchemeGroupVersion.WithKind("Widget")
+	// WidgetGroupVersionResource is the GroupVersionResource of Widget.
+	WidgetGroupVersionResource = SchemeGroupVersion.WithResource("widgets")
+	// WidgetListGroupVersionKind is the GroupVersionKind of WidgetList.
+	WidgetListGroupVersionKind = SchemeGroupVersion.WithKind("WidgetList")
+)
+
+var (
+	// localSchemeBuilder and AddToScheme will stay in k8s.io/kubernetes.
+	SchemeBuilder      runtime.SchemeBuilder
+	localSchemeBuilder = &SchemeBuilder
+	// Deprecated: use Install instead
+	AddToScheme = localSchemeBuilder.AddToScheme
+	Install     = localSchemeBuilder.AddToScheme
+)
+
+func init() {
+	// We only register manually written functions here. The registration of the
+	// generated functions takes place in the generated files. The separation
+	// makes the code compile even when the generated files are missing.
+	localSchemeBuilder.Register(addKnownTypes)
+}
+
+// Adds the list of known types to Scheme.
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Widget{},
+		&WidgetList{},
+	)
+	// AddToGroupVersion allows the serialization of client types like ListOptions.
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
+
+// GadgetsGroupName specifies the group name used to register the objects of the gadgets.example.com group.
+const GadgetsGroupName = "gadgets.example.com"
+
+// GadgetsSchemeGroupVersion is group version used to register the objects of the gadgets.example.com group.
+var GadgetsSchemeGroupVersion = schema.GroupVersion{Group: GadgetsGroupName, Version: "v1"}
+
+// GadgetsResource takes an unqualified resource and returns a GroupResource qualified with the gadgets.example.com group
+func GadgetsResource(resource string) schema.GroupResource {
+	return GadgetsSchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+// GadgetsKind takes an unqualified kind and returns a GroupKind qualified with the gadgets.example.com group
+func GadgetsKind(kind string) schema.GroupKind {
+	return GadgetsSchemeGroupVersion.WithKind(kind).GroupKind()
+}
+
+var (
+	// GadgetGroupVersionKind is the GroupVersionKind of Gadget.
+	GadgetGroupVersionKind = GadgetsSchemeGroupVersion.WithKind("Gadget")
+	// GadgetGroupVersionResource is the GroupVersionResource of Gadget.
+	GadgetGroupVersionResource = GadgetsSchemeGroupVersion.WithResource("gadgets")
+	// GadgetListGroupVersionKind is the GroupVersionKind of GadgetList.
+	GadgetListGroupVersionKind = GadgetsSchemeGroupVersion.WithKind("GadgetList")
+)
+
+var (
+	GadgetsSchemeBuilder = runtime.NewSchemeBuilder(addGadgetsKnownTypes)
+	AddGadgetsToScheme   = GadgetsSchemeBuilder.AddToScheme
+)
+
+// Adds the list of known types of the gadgets.example.com group to Scheme.
+func addGadgetsKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(GadgetsSchemeGroupVersion,
+		&Gadget{},
+		&GadgetList{},
+	)
+	metav1.AddToGroupVersion(scheme, GadgetsSchemeGroupVersion)
+	return nil
+}